@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/yannlawrency/crictty/internal/models"
+	"github.com/yannlawrency/crictty/internal/stats"
+
+	"github.com/spf13/cobra"
+)
+
+// timeLayout is used to print snapshot times
+const timeLayout = "2006-01-02 15:04"
+
+var (
+	showAll   bool
+	olderThan time.Duration
+)
+
+// historyCmd groups the commands working on the local match history
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Browse recorded match snapshots",
+	Long:  "List, inspect and prune the match snapshots recorded while crictty was running",
+}
+
+// historyListCmd lists every recorded match
+var historyListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List recorded matches",
+	Args:    cobra.NoArgs,
+	RunE:    runHistoryList,
+}
+
+// historyShowCmd shows the recorded snapshots of a single match
+var historyShowCmd = &cobra.Command{
+	Use:   "show <match>",
+	Short: "Show the recorded snapshots of a match",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runHistoryShow,
+}
+
+// historySummaryCmd prints the result card of a recorded match
+var historySummaryCmd = &cobra.Command{
+	Use:   "summary <match>",
+	Short: "Print the result card of a finished match",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runHistorySummary,
+}
+
+// historyPruneCmd deletes recorded matches
+var historyPruneCmd = &cobra.Command{
+	Use:   "prune [match...]",
+	Short: "Delete recorded matches",
+	Long:  "Delete the given matches, or every match last updated before --older-than",
+	RunE:  runHistoryPrune,
+}
+
+// init registers the history commands and their flags
+func init() {
+	historyShowCmd.Flags().BoolVarP(&showAll, "all", "a", false, "List every snapshot instead of only the latest")
+	historyPruneCmd.Flags().DurationVar(&olderThan, "older-than", 0, "Delete matches last updated longer ago than this (e.g. 720h)")
+
+	historyCmd.AddCommand(historyListCmd, historyShowCmd, historySummaryCmd, historyPruneCmd)
+	rootCmd.AddCommand(historyCmd)
+}
+
+// runHistoryList prints a table of the recorded matches
+func runHistoryList(cmd *cobra.Command, args []string) error {
+	history, err := openHistory()
+	if err != nil {
+		return err
+	}
+
+	summaries, err := history.List()
+	if err != nil {
+		return err
+	}
+	if len(summaries) == 0 {
+		fmt.Printf("No recorded matches in %s\n", history.Dir())
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tMATCH\tFORMAT\tSNAPSHOTS\tLAST UPDATED\tSTATUS")
+	for _, s := range summaries {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\n",
+			s.MatchID, s.Name, s.Format, s.Snapshots,
+			s.Last.Local().Format(timeLayout), s.Status)
+	}
+	return w.Flush()
+}
+
+// runHistoryShow prints the latest or every recorded snapshot of a match
+func runHistoryShow(cmd *cobra.Command, args []string) error {
+	history, err := openHistory()
+	if err != nil {
+		return err
+	}
+
+	id, err := resolveStoredMatch(history, args[0])
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := history.Snapshots(id)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return fmt.Errorf("no history for match %d", id)
+	}
+
+	latest := snapshots[len(snapshots)-1]
+	header := latest.Match.CricbuzzInfo.MatchHeader
+	fmt.Printf("%s vs %s - %s\n", header.Team1.Name, header.Team2.Name, header.MatchFormat)
+	if header.SeriesName != "" {
+		fmt.Printf("%s, %s\n", header.MatchDescription, header.SeriesName)
+	}
+	fmt.Printf("%d snapshots from %s to %s\n\n",
+		len(snapshots),
+		snapshots[0].Time.Local().Format(timeLayout),
+		latest.Time.Local().Format(timeLayout))
+
+	if !showAll {
+		snapshots = snapshots[len(snapshots)-1:]
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, snap := range snapshots {
+		fmt.Fprintf(w, "%s\t%s\t%s\n",
+			snap.Time.Local().Format(timeLayout),
+			formatScores(snap.Match),
+			snap.Match.CricbuzzInfo.Miniscore.Status)
+	}
+	return w.Flush()
+}
+
+// runHistorySummary prints the result card of the latest snapshot of a
+// finished match as plain text
+func runHistorySummary(cmd *cobra.Command, args []string) error {
+	history, err := openHistory()
+	if err != nil {
+		return err
+	}
+
+	id, err := resolveStoredMatch(history, args[0])
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := history.Snapshots(id)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return fmt.Errorf("no history for match %d", id)
+	}
+
+	summary, ok := stats.ComputeSummary(snapshots[len(snapshots)-1].Match)
+	if !ok {
+		return fmt.Errorf("match %d has not finished", id)
+	}
+	fmt.Print(summary.Text())
+	return nil
+}
+
+// runHistoryPrune deletes the given matches or the ones older than --older-than
+func runHistoryPrune(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 && olderThan == 0 {
+		return fmt.Errorf("give one or more match IDs or --older-than")
+	}
+
+	history, err := openHistory()
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		id, err := resolveStoredMatch(history, arg)
+		if err != nil {
+			return err
+		}
+		if err := history.Delete(id); err != nil {
+			return err
+		}
+		fmt.Printf("Deleted match %d\n", id)
+	}
+
+	if olderThan > 0 {
+		pruned, err := history.Prune(time.Now().Add(-olderThan))
+		for _, id := range pruned {
+			fmt.Printf("Deleted match %d\n", id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatScores joins the innings scores of a match into a single line, in
+// the order they were played
+func formatScores(match models.MatchInfo) string {
+	var scores []string
+	for _, innings := range match.CricbuzzInfo.Miniscore.MatchScoreDetails.Innings() {
+		scores = append(scores, fmt.Sprintf("%s %d/%d (%s)",
+			innings.BatTeamName, innings.Score, innings.Wickets, innings.Overs))
+	}
+	return strings.Join(scores, ", ")
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/yannlawrency/crictty/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+// replayCmd opens a recorded match and scrubs through its snapshots
+var replayCmd = &cobra.Command{
+	Use:   "replay <match>",
+	Short: "Replay a recorded match",
+	Long:  "Scrub through the recorded snapshots of a match as if it were live",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runReplay,
+}
+
+// init registers the replay command
+func init() {
+	rootCmd.AddCommand(replayCmd)
+}
+
+// runReplay loads the snapshots of a match and starts the replay UI
+func runReplay(cmd *cobra.Command, args []string) error {
+	history, err := openHistory()
+	if err != nil {
+		return err
+	}
+
+	id, err := resolveStoredMatch(history, args[0])
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := history.Snapshots(id)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return fmt.Errorf("no history for match %d", id)
+	}
+
+	p := tea.NewProgram(ui.NewReplayModel(snapshots), tea.WithAltScreen(), tea.WithMouseAllMotion())
+	if _, err := p.Run(); err != nil {
+		return fmt.Errorf("error running program: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/cricbuzz"
+	"github.com/yannlawrency/crictty/internal/store"
+)
+
+// resolveMatch turns a match ID, Cricbuzz URL or query like "IND vs AUS" into
+// a match ID, asking the user to pick when several matches fit the query
+func resolveMatch(client *cricbuzz.Client, ref string) (uint32, error) {
+	if id, ok := cricbuzz.ParseMatchRef(ref); ok {
+		return id, nil
+	}
+
+	fmt.Fprintf(os.Stderr, "Looking up %q...\n", ref)
+	fixtures, err := client.FindMatches(ref)
+	if err != nil {
+		return 0, err
+	}
+
+	options := make([]string, len(fixtures))
+	for i, fixture := range fixtures {
+		options[i] = fmt.Sprintf("%s - %s (%d)", fixture.ShortName, fixture.State, fixture.MatchID)
+	}
+	choice, err := choose(ref, options)
+	if err != nil {
+		return 0, err
+	}
+	return fixtures[choice].MatchID, nil
+}
+
+// resolveStoredMatch turns a match ID, Cricbuzz URL or query into the ID of a
+// match in the local history
+func resolveStoredMatch(history *store.Store, ref string) (uint32, error) {
+	if id, ok := cricbuzz.ParseMatchRef(ref); ok {
+		return id, nil
+	}
+
+	summaries, err := history.List()
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []uint32
+	var options []string
+	for _, s := range summaries {
+		if cricbuzz.MatchScore(ref, s.Name) > 0 {
+			ids = append(ids, s.MatchID)
+			options = append(options, fmt.Sprintf("%s - %s, %s (%d)",
+				s.Name, s.Format, s.Last.Local().Format(timeLayout), s.MatchID))
+		}
+	}
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("no recorded match found for %q", ref)
+	}
+
+	choice, err := choose(ref, options)
+	if err != nil {
+		return 0, err
+	}
+	return ids[choice], nil
+}
+
+// choose returns the only option, or prompts on the terminal for one of several
+func choose(ref string, options []string) (int, error) {
+	if len(options) == 1 {
+		return 0, nil
+	}
+
+	fmt.Fprintf(os.Stderr, "Several matches found for %q:\n", ref)
+	for i, option := range options {
+		fmt.Fprintf(os.Stderr, "  %d) %s\n", i+1, option)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Fprintf(os.Stderr, "Pick a match [1-%d]: ", len(options))
+		line, err := reader.ReadString('\n')
+		if n, convErr := strconv.Atoi(strings.TrimSpace(line)); convErr == nil && n >= 1 && n <= len(options) {
+			return n - 1, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("no match picked for %q", ref)
+		}
+	}
+}
@@ -2,10 +2,11 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
 	"time"
 
 	"github.com/yannlawrency/crictty/internal/app"
+	"github.com/yannlawrency/crictty/internal/cricbuzz"
+	"github.com/yannlawrency/crictty/internal/store"
 	"github.com/yannlawrency/crictty/internal/ui"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -13,8 +14,13 @@ import (
 )
 
 var (
-	tickRate int
-	matchID  string
+	tickRate   int
+	matchIDs   []string
+	followLive bool
+	staleAfter time.Duration
+	grace      time.Duration
+	noHistory  bool
+	historyDir string
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -38,61 +44,61 @@ func Execute() error {
 // init initializes the root command and its flags
 func init() {
 	rootCmd.Flags().IntVarP(&tickRate, "tick-rate", "t", 40000, "Sets match details refresh rate in milliseconds")
-	rootCmd.Flags().StringVarP(&matchID, "match-id", "m", "0", "ID of the match to follow live")
+	rootCmd.Flags().StringSliceVarP(&matchIDs, "match-id", "m", nil, "ID, Cricbuzz URL or teams (e.g. \"IND vs AUS\") of a match to follow live, repeat or separate with commas for several")
+	rootCmd.Flags().BoolVarP(&followLive, "live", "L", false, "Follow all live matches on top of the given match IDs")
+	rootCmd.Flags().DurationVar(&grace, "grace", 30*time.Minute, "How long finished live matches stay listed")
+	rootCmd.Flags().DurationVar(&staleAfter, "stale-after", 0, "Warn when match data is older than this (default 3x the tick rate)")
+	rootCmd.Flags().BoolVar(&noHistory, "no-history", false, "Do not record match snapshots to the local history")
+	rootCmd.PersistentFlags().StringVar(&historyDir, "history-dir", "", "Directory of the local match history (default $XDG_DATA_HOME/crictty/history)")
 }
 
-// runCrictty is the main function that runs the application
-func runCrictty(cmd *cobra.Command, args []string) error {
-	// Validate matchID input
-	if matchID != "0" && !isValidMatchID(matchID) {
-		return fmt.Errorf("invalid match ID format")
+// openHistory opens the local match history store
+func openHistory() (*store.Store, error) {
+	dir := historyDir
+	if dir == "" {
+		var err error
+		if dir, err = store.DefaultDir(); err != nil {
+			return nil, err
+		}
 	}
+	return store.Open(dir)
+}
 
-	// Hide cursor during loading
-	fmt.Print("\033[?25l")       // Hide cursor
-	defer fmt.Print("\033[?25h") // Show cursor when function exits
-
-	// Show simple loading message
-	fmt.Print("\nFetching the scoreboard")
-
-	// Simple loading animation
-	done := make(chan bool)
-	go func() {
-		for {
-			select {
-			case <-done:
-				return
-			default:
-				for _, r := range `⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏` {
-					fmt.Printf("\rFetching the scoreboard %c", r)
-					time.Sleep(100 * time.Millisecond)
-				}
-			}
+// runCrictty is the main function that runs the application
+func runCrictty(cmd *cobra.Command, args []string) error {
+	// Resolve matchIDs input, 0 is accepted for backwards compatibility
+	var watch []uint32
+	client := cricbuzz.NewClient()
+	for _, ref := range matchIDs {
+		id, err := resolveMatch(client, ref)
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			watch = append(watch, id)
 		}
-	}()
-
-	// Initialize the application
-	var cricketApp *app.App
-	var err error
-
-	if matchID == "0" {
-		cricketApp, err = app.New()
-	} else {
-		id, _ := strconv.ParseUint(matchID, 10, 32)
-		cricketApp, err = app.NewWithMatchID(uint32(id))
 	}
 
-	// Stop loading animation
-	done <- true
-	fmt.Print("\r                                    \r") // Clear loading line
-
-	if err != nil {
-		return fmt.Errorf("failed to load: %v", err)
+	// Open the history store, the app keeps working without it
+	var history *store.Store
+	if !noHistory {
+		history, _ = openHistory()
 	}
 
+	// Initialize the application, the UI loads the matches itself
+	cricketApp := app.New(app.Config{
+		Watch:       watch,
+		FollowLive:  followLive || len(watch) == 0,
+		GracePeriod: grace,
+		History:     history,
+	})
+
 	// Start main UI
-	model := ui.NewModel(cricketApp, tickRate)
-	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
+	if staleAfter == 0 {
+		staleAfter = 3 * time.Duration(tickRate) * time.Millisecond
+	}
+	model := ui.NewModel(cricketApp, tickRate, staleAfter)
+	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseAllMotion())
 
 	if _, err := p.Run(); err != nil {
 		return fmt.Errorf("error running program: %v", err)
@@ -100,9 +106,3 @@ func runCrictty(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
-
-// isValidMatchID checks if the provided match ID is a valid numeric string
-func isValidMatchID(id string) bool {
-	_, err := strconv.ParseUint(id, 10, 32)
-	return err == nil
-}
@@ -2,83 +2,355 @@ package app
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/yannlawrency/crictty/internal/cricbuzz"
 	"github.com/yannlawrency/crictty/internal/models"
+	"github.com/yannlawrency/crictty/internal/store"
 )
 
+// offListLimit is how long a live match that is not finished keeps being
+// polled after it drops out of the live matches. It covers a night at
+// stumps, so only matches that ended without a result, like abandoned ones,
+// reach it.
+const offListLimit = 24 * time.Hour
+
 // App represents the main application structure
 type App struct {
 	client  *cricbuzz.Client
-	Matches []models.MatchInfo
+	history *store.Store
+
+	// followLive adds every live match on top of the watch list
+	followLive bool
+
+	// gracePeriod is how long a finished live match stays listed
+	gracePeriod time.Duration
+
+	// mu guards the watch list, which the UI edits while a refresh may be
+	// running in the background, and the matches off the live list
+	mu      sync.Mutex
+	watch   []uint32
+	removed map[uint32]bool
+
+	// offList keeps when each unfinished live match was first missing from
+	// the live matches
+	offList map[uint32]time.Time
 }
 
-// New initializes a new App instance with all live matches
-func New() (*App, error) {
-	client := cricbuzz.NewClient()
-	matches, err := client.GetAllLiveMatches()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get live matches: %v", err)
+// Config holds the settings of an App
+type Config struct {
+	// Watch lists the IDs of the matches to follow
+	Watch []uint32
+
+	// FollowLive follows every live match on top of Watch
+	FollowLive bool
+
+	// GracePeriod is how long a finished live match stays listed. Matches
+	// from Watch stay until they are removed.
+	GracePeriod time.Duration
+
+	// History records every snapshot unless it is nil
+	History *store.Store
+}
+
+// New initializes a new App instance from cfg. Nothing is fetched until the
+// UI asks for it.
+func New(cfg Config) *App {
+	a := &App{
+		client:      cricbuzz.NewClient(),
+		history:     cfg.History,
+		followLive:  cfg.FollowLive,
+		gracePeriod: cfg.GracePeriod,
+		removed:     make(map[uint32]bool),
+		offList:     make(map[uint32]time.Time),
 	}
+	for _, id := range cfg.Watch {
+		a.Watch(id)
+	}
+	return a
+}
+
+// FollowsLive reports whether live matches are added on top of the watch list
+func (a *App) FollowsLive() bool {
+	return a.followLive
+}
+
+// Latency returns how long Cricbuzz took to answer the last request
+func (a *App) Latency() time.Duration {
+	return a.client.Latency()
+}
+
+// Watch adds a match ID to the watch list
+func (a *App) Watch(matchID uint32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 
-	return &App{
-		client:  client,
-		Matches: matches,
-	}, nil
+	delete(a.removed, matchID)
+	if !slices.Contains(a.watch, matchID) {
+		a.watch = append(a.watch, matchID)
+	}
 }
 
-// NewWithMatchID initializes a new App instance with a specific match ID
-func NewWithMatchID(matchID uint32) (*App, error) {
-	client := cricbuzz.NewClient()
-	matchInfo, err := client.GetMatchInfo(matchID)
+// Unwatch removes a match ID from the watch list. A live match is also
+// hidden so that the next refresh does not bring it back.
+func (a *App) Unwatch(matchID uint32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.watch = slices.DeleteFunc(a.watch, func(id uint32) bool { return id == matchID })
+	a.removed[matchID] = true
+}
+
+// Watching reports whether a match has not been removed by the user
+func (a *App) Watching(matchID uint32) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return !a.removed[matchID]
+}
+
+// Resolve finds the matches a match ID, Cricbuzz URL or query like
+// "IND vs AUS" refers to, best match first
+func (a *App) Resolve(query string) ([]cricbuzz.Fixture, error) {
+	return a.client.FindMatches(query)
+}
+
+// watchList returns a copy of the watch list and the removed match IDs
+func (a *App) watchList() ([]uint32, map[uint32]bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	removed := make(map[uint32]bool, len(a.removed))
+	for id := range a.removed {
+		removed[id] = true
+	}
+	return slices.Clone(a.watch), removed
+}
+
+// Targets returns the matches to follow -> watched matches first, then live
+// ones. When the live matches cannot be listed the watched ones are still
+// returned along with the error.
+func (a *App) Targets() ([]cricbuzz.Fixture, error) {
+	watch, removed := a.watchList()
+
+	targets := make([]cricbuzz.Fixture, len(watch))
+	for i, id := range watch {
+		targets[i] = cricbuzz.Fixture{MatchID: id}
+	}
+	if !a.followLive {
+		return targets, nil
+	}
+
+	fixtures, err := a.client.GetLiveFixtures()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get match info: %v", err)
+		return targets, fmt.Errorf("failed to get live matches: %v", err)
+	}
+	for _, fixture := range fixtures {
+		if removed[fixture.MatchID] || slices.Contains(watch, fixture.MatchID) {
+			continue
+		}
+		targets = append(targets, fixture)
 	}
+	return targets, nil
+}
 
-	shortName := fmt.Sprintf("%s vs %s",
-		matchInfo.CricbuzzInfo.MatchHeader.Team1.ShortName,
-		matchInfo.CricbuzzInfo.MatchHeader.Team2.ShortName)
-	matchInfo.MatchShortName = shortName
+// Fetch loads a single match and records it in the history
+func (a *App) Fetch(target cricbuzz.Fixture) (models.MatchInfo, error) {
+	matchInfo, err := a.client.GetMatchInfo(target.MatchID)
+	if err != nil {
+		return models.MatchInfo{}, fmt.Errorf("failed to get match info: %v", err)
+	}
 
-	return &App{
-		client:  client,
-		Matches: []models.MatchInfo{matchInfo},
-	}, nil
+	matchInfo.MatchShortName = target.ShortName
+	if matchInfo.MatchShortName == "" {
+		matchInfo.MatchShortName = fmt.Sprintf("%s vs %s",
+			matchInfo.CricbuzzInfo.MatchHeader.Team1.ShortName,
+			matchInfo.CricbuzzInfo.MatchHeader.Team2.ShortName)
+	}
+	a.record(&matchInfo)
+	return matchInfo, nil
 }
 
-// UpdateMatches updates the matches in the App instance
-func (a *App) UpdateMatches() error {
-	if len(a.Matches) == 1 {
-		// Single match mode -> update the specific match
-		matchInfo, err := a.client.GetMatchInfo(a.Matches[0].CricbuzzMatchID)
-		if err != nil {
-			return err
+// Refresh fetches a new snapshot of the followed matches that follows on from
+// current. It never modifies current, so it is safe to call from a background
+// goroutine while the UI keeps reading its own snapshot. A match that fails
+// to load keeps its previous snapshot; an error is only returned when
+// nothing could be loaded at all.
+//
+// Finished matches are not polled again. A live match that drops out of the
+// live matches keeps being fetched on its own until it finishes, then stays
+// listed for the grace period. One that has not finished after offListLimit
+// is taken to have ended without a result and finished there.
+func (a *App) Refresh(current []models.MatchInfo) ([]models.MatchInfo, error) {
+	previous := make(map[uint32]models.MatchInfo, len(current))
+	for _, match := range current {
+		previous[match.CricbuzzMatchID] = match
+	}
+
+	targets, targetsErr := a.Targets()
+	listed := make(map[uint32]bool, len(targets))
+
+	var matches []models.MatchInfo
+	var lastErr error
+	loaded := 0
+	for _, target := range targets {
+		listed[target.MatchID] = true
+		a.backOnList(target.MatchID)
+		prev, seen := previous[target.MatchID]
+		if seen && prev.IsFinished() {
+			matches = append(matches, prev)
+			loaded++
+			continue
 		}
-		matchInfo.MatchShortName = a.Matches[0].MatchShortName
-		matchInfo.LastUpdated = time.Now()
-		a.Matches[0] = matchInfo
-	} else {
-		// Multiple matches mode -> refresh all live matches
-		matches, err := a.client.GetAllLiveMatches()
+
+		matchInfo, err := a.Fetch(target)
 		if err != nil {
-			return err
+			lastErr = err
+			if seen {
+				matches = append(matches, prev)
+			}
+			continue
 		}
-		for i := range matches {
-			matches[i].LastUpdated = time.Now()
+		finish(&matchInfo)
+		matches = append(matches, matchInfo)
+		loaded++
+	}
+
+	// Keep the matches that are no longer listed in their previous place
+	for i, match := range current {
+		if listed[match.CricbuzzMatchID] || !a.Watching(match.CricbuzzMatchID) {
+			continue
+		}
+
+		// Matches also leave the live list at stumps, breaks and rain delays,
+		// so they are only finished once Cricbuzz says so. Until then they
+		// keep being polled, for up to offListLimit.
+		if targetsErr == nil && !match.IsFinished() {
+			if time.Since(a.leftList(match.CricbuzzMatchID)) > offListLimit {
+				match.FinishedAt = time.Now()
+			} else if latest, err := a.Fetch(cricbuzz.Fixture{
+				MatchID:   match.CricbuzzMatchID,
+				ShortName: match.MatchShortName,
+			}); err != nil {
+				lastErr = err
+			} else {
+				match = latest
+				loaded++
+				finish(&match)
+			}
 		}
-		a.Matches = matches
+		matches = slices.Insert(matches, min(i, len(matches)), match)
+	}
+
+	// Drop the finished live matches whose grace period is over
+	matches = slices.DeleteFunc(matches, func(match models.MatchInfo) bool {
+		expires, ok := a.ExpiresAt(match)
+		return ok && time.Now().After(expires)
+	})
+
+	if loaded == 0 {
+		if targetsErr != nil {
+			return nil, targetsErr
+		}
+		if lastErr != nil {
+			return nil, lastErr
+		}
+	}
+	return matches, nil
+}
+
+// finish marks a freshly fetched match finished when Cricbuzz says it is
+// complete or abandoned
+func finish(match *models.MatchInfo) {
+	header := match.CricbuzzInfo.MatchHeader
+	if header.Complete || strings.Contains(strings.ToLower(header.State), "abandon") {
+		match.FinishedAt = match.LastUpdated
+	}
+}
+
+// leftList returns when a live match was first missing from the live
+// matches, recording now if it just dropped out
+func (a *App) leftList(matchID uint32) time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	since, ok := a.offList[matchID]
+	if !ok {
+		since = time.Now()
+		a.offList[matchID] = since
 	}
-	return nil
+	return since
 }
 
-// GetMatchNames returns a slice of match names formatted for display
-func (a *App) GetMatchNames() []string {
-	names := make([]string, len(a.Matches))
-	for i, match := range a.Matches {
+// backOnList forgets when a match dropped out of the live matches once it
+// is listed again
+func (a *App) backOnList(matchID uint32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.offList, matchID)
+}
+
+// ExpiresAt returns when a finished live match will be dropped. Matches that
+// are still going, or that were added to the watch list, never expire.
+func (a *App) ExpiresAt(match models.MatchInfo) (time.Time, bool) {
+	if !match.IsFinished() {
+		return time.Time{}, false
+	}
+
+	a.mu.Lock()
+	watched := slices.Contains(a.watch, match.CricbuzzMatchID)
+	a.mu.Unlock()
+	if watched {
+		return time.Time{}, false
+	}
+
+	finishedAt := match.FinishedAt
+	if finishedAt.IsZero() {
+		finishedAt = match.LastUpdated
+	}
+	return finishedAt.Add(a.gracePeriod), true
+}
+
+// History returns the recorded snapshots of a match, oldest first. History is
+// best effort, so it is empty without a store or when it cannot be read.
+func (a *App) History(matchID uint32) []models.MatchInfo {
+	if a.history == nil {
+		return nil
+	}
+	snapshots, err := a.history.Snapshots(matchID)
+	if err != nil {
+		return nil
+	}
+	matches := make([]models.MatchInfo, len(snapshots))
+	for i, snapshot := range snapshots {
+		matches[i] = snapshot.Match
+	}
+	return matches
+}
+
+// record stamps a freshly fetched match with the current time and saves it
+// to the history store. History is best effort, so failures are ignored.
+func (a *App) record(match *models.MatchInfo) {
+	match.LastUpdated = time.Now()
+	if a.history != nil {
+		_, _ = a.history.Save(*match)
+	}
+}
+
+// MatchNames returns a slice of match names formatted for display
+func MatchNames(matches []models.MatchInfo) []string {
+	names := make([]string, len(matches))
+	for i, match := range matches {
 		names[i] = fmt.Sprintf("%s - %s",
 			match.MatchShortName,
 			match.CricbuzzInfo.MatchHeader.MatchFormat)
+		if match.IsFinished() {
+			names[i] = "✓ " + names[i]
+		}
 	}
 	return names
 }
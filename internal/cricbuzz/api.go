@@ -7,6 +7,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/yannlawrency/crictty/internal/models"
@@ -24,6 +26,7 @@ const (
 // Client represents the Cricbuzz API client
 type Client struct {
 	httpClient *http.Client
+	latency    atomic.Int64
 }
 
 // NewClient initializes a new Cricbuzz API client
@@ -35,13 +38,29 @@ func NewClient() *Client {
 
 const requestInterval = 1 * time.Second
 
-var lastRequest time.Time
+var (
+	requestMu   sync.Mutex
+	lastRequest time.Time
+)
 
 // makeRequest performs an HTTP GET request to the specified URL with rate limiting
 func (c *Client) makeRequest(url string) (*http.Response, error) {
+	requestMu.Lock()
 	time.Sleep(time.Until(lastRequest.Add(requestInterval)))
 	lastRequest = time.Now()
-	return c.httpClient.Get(url)
+	requestMu.Unlock()
+
+	start := time.Now()
+	resp, err := c.httpClient.Get(url)
+	if err == nil {
+		c.latency.Store(int64(time.Since(start)))
+	}
+	return resp, err
+}
+
+// Latency returns how long the last successful request took to respond
+func (c *Client) Latency() time.Duration {
+	return time.Duration(c.latency.Load())
 }
 
 // cleanHTML removes unnecessary HTML tags and attributes from the given HTML content
@@ -73,9 +92,21 @@ func (c *Client) cleanHTML(htmlContent string) string {
 	return cleanText
 }
 
-// GetAllLiveMatches fetches all live matches from Cricbuzz
-func (c *Client) GetAllLiveMatches() ([]models.MatchInfo, error) {
-	// Fetch the Cricbuzz homepage to get live matches
+// Fixture is a match listed in the Cricbuzz navigation menu
+type Fixture struct {
+	MatchID   uint32
+	ShortName string
+	State     string
+}
+
+// IsLive reports whether the fixture is currently being played
+func (f Fixture) IsLive() bool {
+	return f.State == "Live"
+}
+
+// GetFixtures fetches the matches listed in the Cricbuzz navigation menu
+func (c *Client) GetFixtures() ([]Fixture, error) {
+	// Fetch the Cricbuzz homepage to get the match menu
 	resp, err := c.makeRequest(CricbuzzURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch cricbuzz homepage: %v", err)
@@ -88,47 +119,61 @@ func (c *Client) GetAllLiveMatches() ([]models.MatchInfo, error) {
 		return nil, fmt.Errorf("failed to parse HTML: %v", err)
 	}
 
-	// Find all live matches in the navigation menu
-	var matches []models.MatchInfo
+	// Find all matches in the navigation menu
+	var fixtures []Fixture
 	doc.Find("nav.cb-mat-mnu a").Each(func(i int, s *goquery.Selection) {
 		text := strings.TrimSpace(s.Text())
 		if text == "" || text == "MATCHES" {
 			return
 		}
 
-		// Check if the match is live
+		// Split the text into the match name and its state
 		parts := strings.Split(text, "-")
-		if len(parts) >= 2 && strings.TrimSpace(parts[1]) == "Live" {
-			href, exists := s.Attr("href")
-			if !exists {
-				return
-			}
-
-			// Extract match ID from the href
-			pathParts := strings.Split(href, "/")
-			if len(pathParts) < 3 {
-				return
-			}
+		if len(parts) < 2 {
+			return
+		}
 
-			// Convert match ID to uint32
-			matchID, err := strconv.ParseUint(pathParts[2], 10, 32)
-			if err != nil {
-				return
-			}
+		href, exists := s.Attr("href")
+		if !exists {
+			return
+		}
 
-			// Fetch match info using the match ID
-			matchInfo, err := c.GetMatchInfo(uint32(matchID))
-			if err != nil {
-				return
-			}
+		// Extract match ID from the href
+		pathParts := strings.Split(href, "/")
+		if len(pathParts) < 3 {
+			return
+		}
 
-			// Set the match short name and append to the matches slice
-			matchInfo.MatchShortName = strings.TrimSpace(parts[0])
-			matches = append(matches, matchInfo)
+		// Convert match ID to uint32
+		matchID, err := strconv.ParseUint(pathParts[2], 10, 32)
+		if err != nil {
+			return
 		}
+
+		fixtures = append(fixtures, Fixture{
+			MatchID:   uint32(matchID),
+			ShortName: strings.TrimSpace(parts[0]),
+			State:     strings.TrimSpace(parts[1]),
+		})
 	})
 
-	return matches, nil
+	return fixtures, nil
+}
+
+// GetLiveFixtures fetches the matches from the navigation menu that are live
+func (c *Client) GetLiveFixtures() ([]Fixture, error) {
+	fixtures, err := c.GetFixtures()
+	if err != nil {
+		return nil, err
+	}
+
+	var live []Fixture
+	for _, fixture := range fixtures {
+		if fixture.IsLive() {
+			live = append(live, fixture)
+		}
+	}
+	return live, nil
 }
 
 // GetMatchInfo fetches detailed match information for a given match ID
@@ -153,12 +198,14 @@ func (c *Client) GetMatchInfo(matchID uint32) (models.MatchInfo, error) {
 		scorecard = []models.MatchInningsInfo{}
 	}
 
-	return models.MatchInfo{
+	matchInfo := models.MatchInfo{
 		CricbuzzMatchID:      matchID,
 		CricbuzzMatchAPILink: url,
 		CricbuzzInfo:         cricbuzzJSON,
 		Scorecard:            scorecard,
-	}, nil
+	}
+	matchInfo.ApplyBallsPerOver()
+	return matchInfo, nil
 }
 
 // GetScorecard fetches the scorecard for a given match ID
@@ -203,6 +250,27 @@ func (c *Client) parseInningsInfo(inningsDiv *goquery.Selection) models.MatchInn
 		divs := s.Find("div")
 		divCount := divs.Length()
 
+		// Keep the extras and total rows, like "12 (b 0, lb 4, w 7, nb 1, p 0)"
+		if divCount >= 2 {
+			label := strings.ToLower(strings.TrimSpace(divs.Eq(0).Text()))
+			var values []string
+			divs.Slice(1, divCount).Each(func(j int, div *goquery.Selection) {
+				html, _ := div.Html()
+				if text := c.cleanHTML(html); text != "" {
+					values = append(values, text)
+				}
+			})
+
+			switch label {
+			case "extras":
+				innings.Extras = strings.Join(values, " ")
+				return
+			case "total":
+				innings.Total = strings.Join(values, " ")
+				return
+			}
+		}
+
 		if divCount >= 6 {
 			var firstCol, secondCol string
 			if divCount > 0 {
@@ -284,6 +352,14 @@ func (c *Client) parseInningsInfo(inningsDiv *goquery.Selection) models.MatchInn
 		}
 	})
 
+	// Fall of wickets follow their sub header, like "48-1 (Rohit Sharma, 7.3), ..."
+	inningsDiv.Find("div.cb-scrd-sub-hdr").Each(func(i int, s *goquery.Selection) {
+		if strings.Contains(strings.ToLower(s.Text()), "fall of wickets") {
+			innings.FallOfWickets = strings.Join(strings.Fields(s.Next().Text()), " ")
+		}
+	})
+
+	innings.Parse()
 	return innings
 }
 
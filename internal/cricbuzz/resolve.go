@@ -0,0 +1,119 @@
+package cricbuzz
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// matchURLPattern matches the live scores, scorecard and commentary pages of a match
+var matchURLPattern = regexp.MustCompile(
+	`cricbuzz\.com/(?:live-cricket-scores|live-cricket-scorecard|live-cricket-full-commentary|cricket-scores|cricket-scorecard|cricket-full-commentary)/(\d+)`)
+
+// ParseMatchRef extracts a match ID from a numeric ID or a Cricbuzz match URL
+func ParseMatchRef(ref string) (uint32, bool) {
+	ref = strings.TrimSpace(ref)
+	if id, err := strconv.ParseUint(ref, 10, 32); err == nil {
+		return uint32(id), true
+	}
+
+	groups := matchURLPattern.FindStringSubmatch(ref)
+	if groups == nil {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(groups[1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(id), true
+}
+
+// FindMatches resolves a match ID, a Cricbuzz URL or a query like "IND vs AUS"
+// to the matches it refers to. Queries are matched against the live and
+// upcoming fixtures, best match first.
+func (c *Client) FindMatches(query string) ([]Fixture, error) {
+	if id, ok := ParseMatchRef(query); ok {
+		return []Fixture{{MatchID: id}}, nil
+	}
+
+	if len(queryTokens(query)) == 0 {
+		return nil, fmt.Errorf("invalid match ID, URL or query %q", query)
+	}
+
+	fixtures, err := c.GetFixtures()
+	if err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		fixture Fixture
+		score   int
+	}
+	var candidates []candidate
+	seen := make(map[uint32]bool)
+	for _, fixture := range fixtures {
+		if seen[fixture.MatchID] {
+			continue
+		}
+		if score := MatchScore(query, fixture.ShortName); score > 0 {
+			seen[fixture.MatchID] = true
+			candidates = append(candidates, candidate{fixture, score})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no live or upcoming match found for %q", query)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+	result := make([]Fixture, len(candidates))
+	for i, c := range candidates {
+		result[i] = c.fixture
+	}
+	return result, nil
+}
+
+// MatchScore rates how well a query like "ind v aus" fits a match name like
+// "IND vs AUS". Every word of the query has to start a word of the name, or
+// start with it like "india" does with "ind"; an exact word scores higher
+// than a prefix. Zero means no match.
+func MatchScore(query, name string) int {
+	words := queryTokens(name)
+	score := 0
+	for _, token := range queryTokens(query) {
+		best := 0
+		for _, word := range words {
+			switch {
+			case word == token:
+				best = max(best, 2)
+			case strings.HasPrefix(word, token),
+				len(word) >= 3 && strings.HasPrefix(token, word):
+				best = max(best, 1)
+			}
+		}
+		if best == 0 {
+			return 0
+		}
+		score += best
+	}
+	return score
+}
+
+// queryTokens lowercases s and splits it into words, dropping "vs" and "v"
+func queryTokens(s string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+
+	var tokens []string
+	for _, field := range fields {
+		if field == "vs" || field == "v" {
+			continue
+		}
+		tokens = append(tokens, field)
+	}
+	return tokens
+}
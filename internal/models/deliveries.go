@@ -0,0 +1,106 @@
+package models
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DeliveryKind is the outcome of a ball as shown in the recent overs
+type DeliveryKind int
+
+// Kinds of deliveries
+const (
+	Dot DeliveryKind = iota
+	Runs
+	Four
+	Six
+	Wicket
+	Wide
+	NoBall
+	LegBye
+	Bye
+)
+
+// Delivery is a single ball of the recent overs. Runs are the runs scored
+// off it on top of the penalty for a wide or no-ball.
+type Delivery struct {
+	Kind   DeliveryKind
+	Runs   int
+	Wicket bool
+	Raw    string
+}
+
+// IsLegal reports whether the delivery counts towards the over
+func (d Delivery) IsLegal() bool {
+	return d.Kind != Wide && d.Kind != NoBall
+}
+
+// deliveryPattern splits a recent overs token into runs before, the extra
+// or wicket marker and runs after, e.g. "4", "W", "Wd", "2wd", "N4" or "L1"
+var deliveryPattern = regexp.MustCompile(`(?i)^(\d*)(wd|nb|lb|w|n|l|b)?(\d*)$`)
+
+// ParseRecentOvers parses the recent overs sent by Cricbuzz, overs separated
+// by "|" and balls by spaces, into overs of deliveries oldest first. Tokens
+// that cannot be read are skipped and empty overs are dropped.
+func ParseRecentOvers(s string) [][]Delivery {
+	var overs [][]Delivery
+	for _, group := range strings.Split(s, "|") {
+		var over []Delivery
+		for _, token := range strings.Fields(group) {
+			if d, ok := parseDelivery(token); ok {
+				over = append(over, d)
+			}
+		}
+		if len(over) > 0 {
+			overs = append(overs, over)
+		}
+	}
+	return overs
+}
+
+// RecentOvers returns the recent overs of the miniscore as deliveries
+func (m CricbuzzMiniscore) RecentOvers() [][]Delivery {
+	return ParseRecentOvers(m.RecentOvsStats)
+}
+
+// parseDelivery parses a single ball of the recent overs
+func parseDelivery(token string) (Delivery, bool) {
+	if token == "." || token == "•" {
+		return Delivery{Kind: Dot, Raw: token}, true
+	}
+	found := deliveryPattern.FindStringSubmatch(token)
+	if found == nil || (found[1] == "" && found[2] == "" && found[3] == "") {
+		return Delivery{}, false
+	}
+	if found[1] != "" && found[3] != "" {
+		return Delivery{}, false
+	}
+
+	d := Delivery{Raw: token}
+	d.Runs, _ = strconv.Atoi(found[1] + found[3])
+	switch strings.ToLower(found[2]) {
+	case "w":
+		d.Kind, d.Wicket = Wicket, true
+	case "wd":
+		d.Kind = Wide
+	case "nb", "n":
+		d.Kind = NoBall
+	case "lb", "l":
+		d.Kind = LegBye
+	case "b":
+		d.Kind = Bye
+	default:
+		switch d.Runs {
+		case 0:
+			d.Kind = Dot
+		case 4:
+			d.Kind = Four
+		case 6:
+			d.Kind = Six
+		default:
+			d.Kind = Runs
+		}
+	}
+	return d, true
+}
@@ -0,0 +1,107 @@
+package models
+
+import "testing"
+
+func TestParseRecentOvers(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats string
+		want  [][]Delivery
+	}{
+		{
+			name:  "empty",
+			stats: "",
+		},
+		{
+			name:  "runs, dots and boundaries",
+			stats: "1 0 4 0 6 2",
+			want: [][]Delivery{{
+				{Kind: Runs, Runs: 1, Raw: "1"},
+				{Kind: Dot, Raw: "0"},
+				{Kind: Four, Runs: 4, Raw: "4"},
+				{Kind: Dot, Raw: "0"},
+				{Kind: Six, Runs: 6, Raw: "6"},
+				{Kind: Runs, Runs: 2, Raw: "2"},
+			}},
+		},
+		{
+			name:  "extras and a wicket across overs",
+			stats: "... 0 1 Wd 0 W 0 1 | 2wd N4 L1 B4 0 0 4",
+			want: [][]Delivery{
+				{
+					{Kind: Dot, Raw: "0"},
+					{Kind: Runs, Runs: 1, Raw: "1"},
+					{Kind: Wide, Raw: "Wd"},
+					{Kind: Dot, Raw: "0"},
+					{Kind: Wicket, Wicket: true, Raw: "W"},
+					{Kind: Dot, Raw: "0"},
+					{Kind: Runs, Runs: 1, Raw: "1"},
+				},
+				{
+					{Kind: Wide, Runs: 2, Raw: "2wd"},
+					{Kind: NoBall, Runs: 4, Raw: "N4"},
+					{Kind: LegBye, Runs: 1, Raw: "L1"},
+					{Kind: Bye, Runs: 4, Raw: "B4"},
+					{Kind: Dot, Raw: "0"},
+					{Kind: Dot, Raw: "0"},
+					{Kind: Four, Runs: 4, Raw: "4"},
+				},
+			},
+		},
+		{
+			name:  "dot symbols and lower case extras",
+			stats: ". • nb lb1 |",
+			want: [][]Delivery{{
+				{Kind: Dot, Raw: "."},
+				{Kind: Dot, Raw: "•"},
+				{Kind: NoBall, Raw: "nb"},
+				{Kind: LegBye, Runs: 1, Raw: "lb1"},
+			}},
+		},
+		{
+			name:  "malformed tokens are skipped",
+			stats: "1 4x 1W2 ? W | ... |",
+			want: [][]Delivery{{
+				{Kind: Runs, Runs: 1, Raw: "1"},
+				{Kind: Wicket, Wicket: true, Raw: "W"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseRecentOvers(tt.stats)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseRecentOvers(%q) = %d overs %+v, want %d", tt.stats, len(got), got, len(tt.want))
+			}
+			for i := range tt.want {
+				if len(got[i]) != len(tt.want[i]) {
+					t.Fatalf("over %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+				for j := range tt.want[i] {
+					if got[i][j] != tt.want[i][j] {
+						t.Errorf("over %d ball %d = %+v, want %+v", i, j, got[i][j], tt.want[i][j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDeliveryIsLegal(t *testing.T) {
+	for _, tt := range []struct {
+		token string
+		legal bool
+	}{
+		{"0", true}, {"W", true}, {"L1", true}, {"B4", true},
+		{"Wd", false}, {"2wd", false}, {"N", false}, {"N4", false},
+	} {
+		d, ok := parseDelivery(tt.token)
+		if !ok {
+			t.Fatalf("parseDelivery(%q) ok = false", tt.token)
+		}
+		if d.IsLegal() != tt.legal {
+			t.Errorf("parseDelivery(%q).IsLegal() = %v, want %v", tt.token, d.IsLegal(), tt.legal)
+		}
+	}
+}
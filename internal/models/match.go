@@ -1,8 +1,14 @@
 package models
 
-import "time"
+import (
+	"cmp"
+	"slices"
+	"time"
+)
 
-// BowlerInfo contains bowling statistics for a player in a single innings
+// BowlerInfo contains bowling statistics for a player in a single innings.
+// The string fields keep the columns as shown on the scorecard; Stats holds
+// the same figures typed, see Parse.
 type BowlerInfo struct {
 	Name    string
 	Overs   string
@@ -12,9 +18,12 @@ type BowlerInfo struct {
 	NoBalls string
 	Wides   string
 	Economy string
+	Stats   BowlingStats
 }
 
-// BatsmanInfo contains batting statistics for a player in a single innings
+// BatsmanInfo contains batting statistics for a player in a single innings.
+// The string fields keep the columns as shown on the scorecard; Stats holds
+// the same figures typed, see Parse.
 type BatsmanInfo struct {
 	Name       string
 	Status     string
@@ -23,13 +32,23 @@ type BatsmanInfo struct {
 	Fours      string
 	Sixes      string
 	StrikeRate string
+	Stats      BattingStats
 }
 
-// MatchInningsInfo holds all batting and bowling details for an innings
+// MatchInningsInfo holds all batting and bowling details for an innings.
+// Extras, Total and FallOfWickets keep the rows as shown on the scorecard;
+// Totals and FOW hold them typed and Warnings lists the figures that do not
+// add up, see Parse.
 type MatchInningsInfo struct {
 	BatsmanDetails []BatsmanInfo
 	YetToBat       string
 	BowlerDetails  []BowlerInfo
+	Extras         string
+	Total          string
+	FallOfWickets  string
+	Totals         InningsTotals
+	FOW            []FallOfWicket
+	Warnings       []string
 }
 
 // CricbuzzMiniscore contains live match summary information.
@@ -39,20 +58,28 @@ type CricbuzzMiniscore struct {
 	BatsmanNonStriker Batsman           `json:"batsmanNonStriker"`
 	BowlerStriker     Bowler            `json:"bowlerStriker"`
 	BowlerNonStriker  Bowler            `json:"bowlerNonStriker"`
-	Overs             float32           `json:"overs"`
+	Overs             Overs             `json:"overs"`
 	RecentOvsStats    string            `json:"recentOvsStats"`
 	CurrentRunRate    float32           `json:"currentRunRate"`
 	RequiredRunRate   float32           `json:"requiredRunRate"`
 	LastWicket        *string           `json:"lastWicket"`
+	PartnerShip       PartnerShip       `json:"partnerShip"`
 	MatchScoreDetails MatchScoreDetails `json:"matchScoreDetails"`
-	OversRem          *float32          `json:"oversRem"`
+	OversRem          *Overs            `json:"oversRem"`
 	Status            string            `json:"status"`
 }
 
-// Batsman represents batting stats for a player as received from the API
+// PartnerShip is the current partnership as received from the API
+type PartnerShip struct {
+	Balls uint32 `json:"balls"`
+	Runs  uint32 `json:"runs"`
+}
+
+// Batsman represents batting stats for a player as received from the API.
+// BatDots is nil when the feed leaves the dot balls out.
 type Batsman struct {
 	BatBalls      uint32  `json:"batBalls"`
-	BatDots       uint32  `json:"batDots"`
+	BatDots       *uint32 `json:"batDots"`
 	BatFours      uint32  `json:"batFours"`
 	BatID         uint32  `json:"batId"`
 	BatName       string  `json:"batName"`
@@ -68,7 +95,7 @@ type Bowler struct {
 	BowlName    string  `json:"bowlName"`
 	BowlMaidens uint32  `json:"bowlMaidens"`
 	BowlNoballs uint32  `json:"bowlNoballs"`
-	BowlOvs     float32 `json:"bowlOvs"`
+	BowlOvs     Overs   `json:"bowlOvs"`
 	BowlRuns    uint32  `json:"bowlRuns"`
 	BowlWides   uint32  `json:"bowlWides"`
 	BowlWkts    uint32  `json:"bowlWkts"`
@@ -84,38 +111,68 @@ type MatchScoreDetails struct {
 	InningsScoreList []InningsScore `json:"inningsScoreList"`
 }
 
+// Innings returns the innings in the order they were played. Cricbuzz does
+// not keep InningsScoreList in any particular order.
+func (d MatchScoreDetails) Innings() []InningsScore {
+	innings := slices.Clone(d.InningsScoreList)
+	slices.SortFunc(innings, func(a, b InningsScore) int {
+		return cmp.Compare(a.InningsID, b.InningsID)
+	})
+	return innings
+}
+
 // InningsScore contains summary of runs, wickets, and overs for an innings
 type InningsScore struct {
-	InningsID   uint32  `json:"inningsId"`
-	BatTeamID   uint32  `json:"batTeamId"`
-	BatTeamName string  `json:"batTeamName"`
-	Score       uint32  `json:"score"`
-	Wickets     uint32  `json:"wickets"`
-	Overs       float32 `json:"overs"`
-	IsDeclared  bool    `json:"isDeclared"`
-	IsFollowOn  bool    `json:"isFollowOn"`
+	InningsID   uint32 `json:"inningsId"`
+	BatTeamID   uint32 `json:"batTeamId"`
+	BatTeamName string `json:"batTeamName"`
+	Score       uint32 `json:"score"`
+	Wickets     uint32 `json:"wickets"`
+	Overs       Overs  `json:"overs"`
+	IsDeclared  bool   `json:"isDeclared"`
+	IsFollowOn  bool   `json:"isFollowOn"`
 }
 
 // MatchHeader contains metadata about the match
 type MatchHeader struct {
-	MatchID                uint32  `json:"matchId"`
-	MatchDescription       string  `json:"matchDescription"`
-	MatchFormat            string  `json:"matchFormat"`
-	MatchType              string  `json:"matchType"`
-	Complete               bool    `json:"complete"`
-	Domestic               bool    `json:"domestic"`
-	MatchStartTimestamp    uint64  `json:"matchStartTimestamp"`
-	MatchCompleteTimestamp uint64  `json:"matchCompleteTimestamp"`
-	DayNight               *bool   `json:"dayNight"`
-	Year                   uint32  `json:"year"`
-	DayNumber              *uint32 `json:"dayNumber"`
-	State                  string  `json:"state"`
-	Status                 string  `json:"status"`
-	Team1                  Team    `json:"team1"`
-	Team2                  Team    `json:"team2"`
-	SeriesDesc             string  `json:"seriesDesc"`
-	SeriesID               uint32  `json:"seriesId"`
-	SeriesName             string  `json:"seriesName"`
+	MatchID                uint32   `json:"matchId"`
+	MatchDescription       string   `json:"matchDescription"`
+	MatchFormat            string   `json:"matchFormat"`
+	MatchType              string   `json:"matchType"`
+	Complete               bool     `json:"complete"`
+	Domestic               bool     `json:"domestic"`
+	MatchStartTimestamp    uint64   `json:"matchStartTimestamp"`
+	MatchCompleteTimestamp uint64   `json:"matchCompleteTimestamp"`
+	DayNight               *bool    `json:"dayNight"`
+	Year                   uint32   `json:"year"`
+	DayNumber              *uint32  `json:"dayNumber"`
+	State                  string   `json:"state"`
+	Status                 string   `json:"status"`
+	Team1                  Team     `json:"team1"`
+	Team2                  Team     `json:"team2"`
+	SeriesDesc             string   `json:"seriesDesc"`
+	SeriesID               uint32   `json:"seriesId"`
+	SeriesName             string   `json:"seriesName"`
+	Result                 *Result  `json:"result"`
+	PlayersOfTheMatch      []Player `json:"playersOfTheMatch"`
+	PlayersOfTheSeries     []Player `json:"playersOfTheSeries"`
+}
+
+// Result contains the outcome of a completed match
+type Result struct {
+	ResultType    string `json:"resultType"`
+	WinningTeam   string `json:"winningTeam"`
+	WinningTeamID uint32 `json:"winningteamId"`
+	WinningMargin uint32 `json:"winningMargin"`
+	WinByRuns     bool   `json:"winByRuns"`
+	WinByInnings  bool   `json:"winByInnings"`
+}
+
+// Player contains player identification and names
+type Player struct {
+	ID       uint32 `json:"id"`
+	Name     string `json:"name"`
+	FullName string `json:"fullName"`
 }
 
 // Team contains team identification and names
@@ -127,9 +184,23 @@ type Team struct {
 
 // CricbuzzJSON contains match header, miniscore, and page info
 type CricbuzzJSON struct {
-	MatchHeader MatchHeader       `json:"matchHeader"`
-	Miniscore   CricbuzzMiniscore `json:"miniscore"`
-	Page        string            `json:"page"`
+	MatchHeader    MatchHeader       `json:"matchHeader"`
+	Miniscore      CricbuzzMiniscore `json:"miniscore"`
+	CommentaryList []Commentary      `json:"commentaryList"`
+	Page           string            `json:"page"`
+}
+
+// Commentary is a single entry of the live commentary, newest first as
+// received from the API. Ball entries carry the figures of the striker and
+// the bowler after the ball.
+type Commentary struct {
+	CommText       string  `json:"commText"`
+	Timestamp      int64   `json:"timestamp"`
+	BallNbr        uint32  `json:"ballNbr"`
+	InningsID      uint32  `json:"inningsId"`
+	Event          string  `json:"event"`
+	BatsmanStriker Batsman `json:"batsmanStriker"`
+	BowlerStriker  Bowler  `json:"bowlerStriker"`
 }
 
 // MatchInfo contains match metadata, live data, and scorecard
@@ -140,4 +211,48 @@ type MatchInfo struct {
 	CricbuzzInfo         CricbuzzJSON
 	Scorecard            []MatchInningsInfo
 	LastUpdated          time.Time
+	FinishedAt           time.Time
+}
+
+// ApplyBallsPerOver reads every overs figure of the match with the number of
+// balls per over of its format. Cricbuzz and the history store both encode
+// overs as plain numbers, so this runs after decoding either.
+func (m *MatchInfo) ApplyBallsPerOver() {
+	perOver := BallsPerOver(m.CricbuzzInfo.MatchHeader.MatchFormat)
+	apply := func(o *Overs) {
+		*o = o.WithBallsPerOver(perOver)
+	}
+
+	miniscore := &m.CricbuzzInfo.Miniscore
+	apply(&miniscore.Overs)
+	if miniscore.OversRem != nil {
+		apply(miniscore.OversRem)
+	}
+	apply(&miniscore.BowlerStriker.BowlOvs)
+	apply(&miniscore.BowlerNonStriker.BowlOvs)
+	for i := range miniscore.MatchScoreDetails.InningsScoreList {
+		apply(&miniscore.MatchScoreDetails.InningsScoreList[i].Overs)
+	}
+	for i := range m.CricbuzzInfo.CommentaryList {
+		apply(&m.CricbuzzInfo.CommentaryList[i].BowlerStriker.BowlOvs)
+	}
+
+	for i := range m.Scorecard {
+		innings := &m.Scorecard[i]
+		apply(&innings.Totals.Overs)
+		for j := range innings.FOW {
+			apply(&innings.FOW[j].Overs)
+		}
+		for j := range innings.BowlerDetails {
+			apply(&innings.BowlerDetails[j].Stats.Overs)
+		}
+	}
+}
+
+// IsFinished reports whether the match is over, either because Cricbuzz says
+// it is complete or because FinishedAt was set, when the match was complete
+// or abandoned, or was given up on long after it dropped out of the live
+// matches
+func (m MatchInfo) IsFinished() bool {
+	return m.CricbuzzInfo.MatchHeader.Complete || !m.FinishedAt.IsZero()
 }
@@ -0,0 +1,187 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultBallsPerOver is the number of legal balls in a regular over
+const DefaultBallsPerOver = 6
+
+// Overs is a number of overs stored as legal balls, so 4.2 overs of six balls
+// is 26 balls. PerOver is the number of balls in an over, zero meaning six;
+// The Hundred is bowled in sets of five.
+type Overs struct {
+	Balls   int
+	PerOver int
+}
+
+// BallsToOvers returns the overs made up of the given number of legal balls
+func BallsToOvers(balls, perOver int) Overs {
+	return Overs{Balls: balls, PerOver: perOver}
+}
+
+// ParseOvers parses overs written the cricket way, like "4.2" for four overs
+// and two balls, assuming six ball overs
+func ParseOvers(s string) (Overs, error) {
+	s = strings.TrimSpace(s)
+	whole, part, _ := strings.Cut(s, ".")
+
+	overs, err := strconv.Atoi(whole)
+	if err != nil || overs < 0 {
+		return Overs{}, fmt.Errorf("invalid overs %q", s)
+	}
+
+	balls := 0
+	if part != "" {
+		balls, err = strconv.Atoi(part)
+		if err != nil || len(part) != 1 || balls >= DefaultBallsPerOver {
+			return Overs{}, fmt.Errorf("invalid overs %q", s)
+		}
+	}
+
+	return Overs{Balls: overs*DefaultBallsPerOver + balls}, nil
+}
+
+// ballsPerOver returns the number of balls in an over
+func (o Overs) ballsPerOver() int {
+	if o.PerOver <= 0 {
+		return DefaultBallsPerOver
+	}
+	return o.PerOver
+}
+
+// Completed returns the number of completed overs
+func (o Overs) Completed() int {
+	return o.Balls / o.ballsPerOver()
+}
+
+// Remainder returns the number of balls bowled in the over in progress
+func (o Overs) Remainder() int {
+	return o.Balls % o.ballsPerOver()
+}
+
+// Add returns the sum of two overs figures, counted in balls
+func (o Overs) Add(other Overs) Overs {
+	return Overs{Balls: o.Balls + other.Balls, PerOver: o.PerOver}
+}
+
+// AddBalls returns the overs after n more legal balls
+func (o Overs) AddBalls(n int) Overs {
+	return Overs{Balls: o.Balls + n, PerOver: o.PerOver}
+}
+
+// Sub returns the overs left after taking other away, never below zero
+func (o Overs) Sub(other Overs) Overs {
+	return Overs{Balls: max(o.Balls-other.Balls, 0), PerOver: o.PerOver}
+}
+
+// Float64 returns the overs as a true fraction, so 4.3 six ball overs is 4.5.
+// This is the figure to use for run rates.
+func (o Overs) Float64() float64 {
+	return float64(o.Balls) / float64(o.ballsPerOver())
+}
+
+// WithBallsPerOver reads the same notation with another number of balls per
+// over, so 16.4 becomes 84 balls in five ball sets instead of 100 in overs
+func (o Overs) WithBallsPerOver(perOver int) Overs {
+	return Overs{
+		Balls:   o.Completed()*perOver + o.Remainder(),
+		PerOver: perOver,
+	}
+}
+
+// String formats the overs the cricket way, like "4.2"
+func (o Overs) String() string {
+	return fmt.Sprintf("%d.%d", o.Completed(), o.Remainder())
+}
+
+// MarshalJSON encodes the overs as a number the way Cricbuzz does, like 19.5
+func (o Overs) MarshalJSON() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalJSON decodes overs from a Cricbuzz number like 19.5 or a string,
+// assuming six ball overs until WithBallsPerOver says otherwise. A ball digit
+// of six or more, like 19.6, carries into the next over rather than failing
+// the whole match.
+func (o *Overs) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	if text == "null" || text == "" {
+		*o = Overs{}
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil || f < 0 {
+		return fmt.Errorf("invalid overs %s", data)
+	}
+
+	// Round the ball digit, as floats like 19.499999 turn up in the API
+	overs := int(f)
+	balls := int(math.Round((f - float64(overs)) * 10))
+	if balls >= 10 {
+		overs, balls = overs+1, 0
+	}
+	if balls >= DefaultBallsPerOver {
+		overs, balls = overs+1, balls-DefaultBallsPerOver
+	}
+	*o = Overs{Balls: overs*DefaultBallsPerOver + balls}
+	return nil
+}
+
+// BallsPerOver returns the number of balls in an over for a match format.
+// The Hundred is bowled in sets of five, everything else in overs of six.
+func BallsPerOver(format string) int {
+	if strings.Contains(strings.ToUpper(format), "HUNDRED") {
+		return 5
+	}
+	return DefaultBallsPerOver
+}
+
+// Decimal is a non-negative number with two decimal places, stored in hundredths
+type Decimal int64
+
+// ParseDecimal parses a number with up to two decimal places, like "133.33"
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	whole, part, _ := strings.Cut(s, ".")
+
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || n < 0 || len(part) > 2 {
+		return 0, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	hundredths := int64(0)
+	if part != "" {
+		if hundredths, err = strconv.ParseInt(part, 10, 64); err != nil || hundredths < 0 {
+			return 0, fmt.Errorf("invalid decimal %q", s)
+		}
+		if len(part) == 1 {
+			hundredths *= 10
+		}
+	}
+
+	return Decimal(n*100 + hundredths), nil
+}
+
+// Float64 returns the decimal as a float
+func (d Decimal) Float64() float64 {
+	return float64(d) / 100
+}
+
+// String formats the decimal with two decimal places
+func (d Decimal) String() string {
+	return fmt.Sprintf("%d.%02d", d/100, d%100)
+}
+
+// parseCount parses a non-negative whole number column like runs or balls
+func parseCount(s string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return n, nil
+}
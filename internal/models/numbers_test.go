@@ -0,0 +1,151 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOversUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		balls int
+		ok    bool
+	}{
+		{name: "whole overs", data: `20`, balls: 120, ok: true},
+		{name: "part over", data: `19.5`, balls: 119, ok: true},
+		{name: "string", data: `"4.2"`, balls: 26, ok: true},
+		{name: "float noise below", data: `19.499999`, balls: 119, ok: true},
+		{name: "float noise above", data: `19.99999`, balls: 120, ok: true},
+		{name: "six balls carry over", data: `19.6`, balls: 120, ok: true},
+		{name: "extra balls carry over", data: `19.7`, balls: 121, ok: true},
+		{name: "null", data: `null`, balls: 0, ok: true},
+		{name: "empty string", data: `""`, balls: 0, ok: true},
+		{name: "negative", data: `-1.2`},
+		{name: "not a number", data: `"four"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o Overs
+			err := json.Unmarshal([]byte(tt.data), &o)
+			if (err == nil) != tt.ok {
+				t.Fatalf("Unmarshal(%s) error = %v, want ok %v", tt.data, err, tt.ok)
+			}
+			if tt.ok && o.Balls != tt.balls {
+				t.Errorf("Unmarshal(%s) = %d balls, want %d", tt.data, o.Balls, tt.balls)
+			}
+		})
+	}
+}
+
+func TestOversMarshalRoundTrip(t *testing.T) {
+	for _, overs := range []Overs{
+		BallsToOvers(0, 6),
+		BallsToOvers(26, 6),
+		BallsToOvers(120, 6),
+		BallsToOvers(299, 6),
+	} {
+		data, err := json.Marshal(overs)
+		if err != nil {
+			t.Fatalf("Marshal(%v) error = %v", overs, err)
+		}
+		var got Overs
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", data, err)
+		}
+		if got.Balls != overs.Balls {
+			t.Errorf("round trip of %v through %s = %d balls, want %d", overs, data, got.Balls, overs.Balls)
+		}
+	}
+}
+
+func TestOversWithBallsPerOver(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		perOver int
+		balls   int
+		text    string
+	}{
+		{name: "Hundred part set", data: `16.4`, perOver: 5, balls: 84, text: "16.4"},
+		{name: "Hundred full innings", data: `20`, perOver: 5, balls: 100, text: "20.0"},
+		{name: "six ball overs unchanged", data: `16.4`, perOver: 6, balls: 100, text: "16.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o Overs
+			if err := json.Unmarshal([]byte(tt.data), &o); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", tt.data, err)
+			}
+			got := o.WithBallsPerOver(tt.perOver)
+			if got.Balls != tt.balls || got.String() != tt.text {
+				t.Errorf("WithBallsPerOver(%d) = %d balls %q, want %d balls %q",
+					tt.perOver, got.Balls, got, tt.balls, tt.text)
+			}
+		})
+	}
+}
+
+func TestBallsToOvers(t *testing.T) {
+	tests := []struct {
+		balls     int
+		perOver   int
+		completed int
+		remainder int
+		text      string
+		float     float64
+	}{
+		{balls: 0, perOver: 6, completed: 0, remainder: 0, text: "0.0", float: 0},
+		{balls: 27, perOver: 6, completed: 4, remainder: 3, text: "4.3", float: 4.5},
+		{balls: 120, perOver: 6, completed: 20, remainder: 0, text: "20.0", float: 20},
+		{balls: 27, perOver: 0, completed: 4, remainder: 3, text: "4.3", float: 4.5},
+		{balls: 84, perOver: 5, completed: 16, remainder: 4, text: "16.4", float: 16.8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			o := BallsToOvers(tt.balls, tt.perOver)
+			if o.Completed() != tt.completed || o.Remainder() != tt.remainder {
+				t.Errorf("BallsToOvers(%d, %d) = %d.%d, want %d.%d",
+					tt.balls, tt.perOver, o.Completed(), o.Remainder(), tt.completed, tt.remainder)
+			}
+			if o.String() != tt.text {
+				t.Errorf("String() = %q, want %q", o, tt.text)
+			}
+			if o.Float64() != tt.float {
+				t.Errorf("Float64() = %v, want %v", o.Float64(), tt.float)
+			}
+		})
+	}
+}
+
+func TestParseOvers(t *testing.T) {
+	tests := []struct {
+		text  string
+		balls int
+		ok    bool
+	}{
+		{text: "4.2", balls: 26, ok: true},
+		{text: " 20 ", balls: 120, ok: true},
+		{text: "0.5", balls: 5, ok: true},
+		{text: "19.6"},
+		{text: "19.7"},
+		{text: "4.12"},
+		{text: "-1"},
+		{text: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseOvers(tt.text)
+			if (err == nil) != tt.ok {
+				t.Fatalf("ParseOvers(%q) error = %v, want ok %v", tt.text, err, tt.ok)
+			}
+			if tt.ok && got.Balls != tt.balls {
+				t.Errorf("ParseOvers(%q) = %d balls, want %d", tt.text, got.Balls, tt.balls)
+			}
+		})
+	}
+}
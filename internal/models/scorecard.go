@@ -0,0 +1,258 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// rateTolerance is how far a rounded rate from the scorecard may be from the computed one
+const rateTolerance = 0.011
+
+// BattingStats contains the typed batting figures parsed from a BatsmanInfo
+type BattingStats struct {
+	Runs       int
+	Balls      int
+	Fours      int
+	Sixes      int
+	StrikeRate Decimal
+}
+
+// BowlingStats contains the typed bowling figures parsed from a BowlerInfo
+type BowlingStats struct {
+	Overs   Overs
+	Maidens int
+	Runs    int
+	Wickets int
+	NoBalls int
+	Wides   int
+	Economy Decimal
+}
+
+// InningsTotals contains the extras and total of an innings
+type InningsTotals struct {
+	Extras  int
+	Runs    int
+	Wickets int
+	Overs   Overs
+}
+
+// FallOfWicket is a wicket of an innings as listed on the scorecard
+type FallOfWicket struct {
+	Wicket int
+	Runs   int
+	Batter string
+	Overs  Overs
+}
+
+// Parse fills Stats from the raw columns and checks that they add up
+func (b *BatsmanInfo) Parse() error {
+	var errs []error
+	parse := func(raw string, dst *int) {
+		n, err := parseCount(raw)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		*dst = n
+	}
+
+	stats := BattingStats{}
+	parse(b.Runs, &stats.Runs)
+	parse(b.Balls, &stats.Balls)
+	parse(b.Fours, &stats.Fours)
+	parse(b.Sixes, &stats.Sixes)
+
+	if b.StrikeRate != "" {
+		sr, err := ParseDecimal(b.StrikeRate)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		stats.StrikeRate = sr
+	}
+	b.Stats = stats
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", b.Name, errors.Join(errs...))
+	}
+
+	// Boundaries cannot be worth more than the runs scored
+	if stats.Fours*4+stats.Sixes*6 > stats.Runs {
+		return fmt.Errorf("%s: %d fours and %d sixes exceed %d runs", b.Name, stats.Fours, stats.Sixes, stats.Runs)
+	}
+
+	// The strike rate has to match runs and balls
+	if stats.Balls > 0 && b.StrikeRate != "" {
+		want := float64(stats.Runs) * 100 / float64(stats.Balls)
+		if math.Abs(want-stats.StrikeRate.Float64()) > rateTolerance {
+			return fmt.Errorf("%s: strike rate %s does not match %d runs off %d balls", b.Name, stats.StrikeRate, stats.Runs, stats.Balls)
+		}
+	}
+
+	return nil
+}
+
+// Parse fills Stats from the raw columns and checks that they add up
+func (b *BowlerInfo) Parse() error {
+	var errs []error
+	parse := func(raw string, dst *int) {
+		n, err := parseCount(raw)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		*dst = n
+	}
+
+	stats := BowlingStats{}
+	overs, err := ParseOvers(b.Overs)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	stats.Overs = overs
+	parse(b.Maidens, &stats.Maidens)
+	parse(b.Runs, &stats.Runs)
+	parse(b.Wickets, &stats.Wickets)
+	parse(b.NoBalls, &stats.NoBalls)
+	parse(b.Wides, &stats.Wides)
+
+	if b.Economy != "" {
+		econ, err := ParseDecimal(b.Economy)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		stats.Economy = econ
+	}
+	b.Stats = stats
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", b.Name, errors.Join(errs...))
+	}
+
+	// A bowler cannot bowl more maidens than completed overs
+	if stats.Maidens > stats.Overs.Completed() {
+		return fmt.Errorf("%s: %d maidens in %s overs", b.Name, stats.Maidens, stats.Overs)
+	}
+
+	// The economy has to match runs and overs
+	if stats.Overs.Balls > 0 && b.Economy != "" {
+		want := float64(stats.Runs) / stats.Overs.Float64()
+		if math.Abs(want-stats.Economy.Float64()) > rateTolerance {
+			return fmt.Errorf("%s: economy %s does not match %d runs off %s overs", b.Name, stats.Economy, stats.Runs, stats.Overs)
+		}
+	}
+
+	return nil
+}
+
+var (
+	totalWicketsPattern = regexp.MustCompile(`(\d+)\s*wkts?`)
+	totalOversPattern   = regexp.MustCompile(`(\d+(?:\.\d)?)\s*Ov`)
+	fallOfWicketPattern = regexp.MustCompile(`(\d+)-(\d+)\s*\(([^,()]+),\s*(\d+(?:\.\d)?)(?:\s*ov)?\)`)
+)
+
+// Parse fills the typed figures of every row and the totals, and records
+// anything that does not add up in Warnings
+func (i *MatchInningsInfo) Parse() {
+	i.Warnings = nil
+
+	for j := range i.BatsmanDetails {
+		if err := i.BatsmanDetails[j].Parse(); err != nil {
+			i.Warnings = append(i.Warnings, err.Error())
+		}
+	}
+	for j := range i.BowlerDetails {
+		if err := i.BowlerDetails[j].Parse(); err != nil {
+			i.Warnings = append(i.Warnings, err.Error())
+		}
+	}
+
+	if err := i.parseFallOfWickets(); err != nil {
+		i.Warnings = append(i.Warnings, err.Error())
+	}
+	if err := i.parseTotals(); err != nil {
+		i.Warnings = append(i.Warnings, err.Error())
+		return
+	}
+	if err := i.CheckTotals(); err != nil {
+		i.Warnings = append(i.Warnings, err.Error())
+	}
+}
+
+// parseFallOfWickets fills FOW from the raw fall of wickets row, like
+// "48-1 (Rohit Sharma, 7.3), 92-2 (Virat Kohli, 15.1)"
+func (i *MatchInningsInfo) parseFallOfWickets() error {
+	i.FOW = nil
+	for _, groups := range fallOfWicketPattern.FindAllStringSubmatch(i.FallOfWickets, -1) {
+		runs, _ := parseCount(groups[1])
+		wicket, _ := parseCount(groups[2])
+		overs, err := ParseOvers(groups[4])
+		if err != nil {
+			return fmt.Errorf("fall of wickets: %w", err)
+		}
+		i.FOW = append(i.FOW, FallOfWicket{
+			Wicket: wicket,
+			Runs:   runs,
+			Batter: strings.TrimSpace(groups[3]),
+			Overs:  overs,
+		})
+	}
+
+	// Wickets fall in order and the score never goes down
+	for j := 1; j < len(i.FOW); j++ {
+		if i.FOW[j].Wicket <= i.FOW[j-1].Wicket || i.FOW[j].Runs < i.FOW[j-1].Runs {
+			return fmt.Errorf("fall of wickets: %d-%d follows %d-%d",
+				i.FOW[j].Runs, i.FOW[j].Wicket, i.FOW[j-1].Runs, i.FOW[j-1].Wicket)
+		}
+	}
+	return nil
+}
+
+// parseTotals fills Totals from the raw extras and total rows
+func (i *MatchInningsInfo) parseTotals() error {
+	i.Totals = InningsTotals{}
+	if i.Extras == "" && i.Total == "" {
+		return nil
+	}
+
+	extras, _, _ := strings.Cut(strings.TrimSpace(i.Extras), " ")
+	n, err := parseCount(extras)
+	if err != nil {
+		return fmt.Errorf("extras: %w", err)
+	}
+	i.Totals.Extras = n
+
+	total, detail, _ := strings.Cut(strings.TrimSpace(i.Total), " ")
+	if n, err = parseCount(total); err != nil {
+		return fmt.Errorf("total: %w", err)
+	}
+	i.Totals.Runs = n
+
+	if groups := totalWicketsPattern.FindStringSubmatch(detail); groups != nil {
+		i.Totals.Wickets, _ = parseCount(groups[1])
+	}
+	if groups := totalOversPattern.FindStringSubmatch(detail); groups != nil {
+		if i.Totals.Overs, err = ParseOvers(groups[1]); err != nil {
+			return fmt.Errorf("total: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CheckTotals checks that the runs of every batter plus the extras make up
+// the innings total
+func (i MatchInningsInfo) CheckTotals() error {
+	if i.Total == "" {
+		return nil
+	}
+
+	runs := i.Totals.Extras
+	for _, bat := range i.BatsmanDetails {
+		runs += bat.Stats.Runs
+	}
+	if runs != i.Totals.Runs {
+		return fmt.Errorf("batting runs plus %d extras make %d, but the total is %d", i.Totals.Extras, runs, i.Totals.Runs)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBatsmanInfoParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		bat   BatsmanInfo
+		stats BattingStats
+		err   string
+	}{
+		{
+			name:  "valid",
+			bat:   BatsmanInfo{Name: "Rohit Sharma", Runs: "52", Balls: "39", Fours: "6", Sixes: "2", StrikeRate: "133.33"},
+			stats: BattingStats{Runs: 52, Balls: 39, Fours: 6, Sixes: 2, StrikeRate: 13333},
+		},
+		{
+			name:  "no strike rate yet",
+			bat:   BatsmanInfo{Name: "Virat Kohli", Runs: "0", Balls: "0", Fours: "0", Sixes: "0"},
+			stats: BattingStats{},
+		},
+		{
+			name: "not a number",
+			bat:  BatsmanInfo{Name: "Shubman Gill", Runs: "12*", Balls: "10", Fours: "1", Sixes: "0"},
+			err:  `invalid number "12*"`,
+		},
+		{
+			name: "boundaries exceed runs",
+			bat:  BatsmanInfo{Name: "KL Rahul", Runs: "10", Balls: "8", Fours: "2", Sixes: "1"},
+			err:  "2 fours and 1 sixes exceed 10 runs",
+		},
+		{
+			name: "strike rate mismatch",
+			bat:  BatsmanInfo{Name: "Rishabh Pant", Runs: "30", Balls: "20", Fours: "3", Sixes: "1", StrikeRate: "120.00"},
+			err:  "strike rate 120.00 does not match 30 runs off 20 balls",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bat.Parse()
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Parse() error = %v, want one containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if tt.bat.Stats != tt.stats {
+				t.Errorf("Stats = %+v, want %+v", tt.bat.Stats, tt.stats)
+			}
+		})
+	}
+}
+
+func TestBowlerInfoParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		bowl  BowlerInfo
+		stats BowlingStats
+		err   string
+	}{
+		{
+			name:  "valid",
+			bowl:  BowlerInfo{Name: "Pat Cummins", Overs: "9.3", Maidens: "1", Runs: "48", Wickets: "2", NoBalls: "1", Wides: "2", Economy: "5.05"},
+			stats: BowlingStats{Overs: Overs{Balls: 57}, Maidens: 1, Runs: 48, Wickets: 2, NoBalls: 1, Wides: 2, Economy: 505},
+		},
+		{
+			name: "ball digit out of range",
+			bowl: BowlerInfo{Name: "Mitchell Starc", Overs: "4.7", Maidens: "0", Runs: "30", Wickets: "0", NoBalls: "0", Wides: "0"},
+			err:  `invalid overs "4.7"`,
+		},
+		{
+			name: "more maidens than overs",
+			bowl: BowlerInfo{Name: "Josh Hazlewood", Overs: "2.4", Maidens: "3", Runs: "4", Wickets: "0", NoBalls: "0", Wides: "0"},
+			err:  "3 maidens in 2.4 overs",
+		},
+		{
+			name: "economy mismatch",
+			bowl: BowlerInfo{Name: "Adam Zampa", Overs: "10", Maidens: "0", Runs: "55", Wickets: "1", NoBalls: "0", Wides: "0", Economy: "5.00"},
+			err:  "economy 5.00 does not match 55 runs off 10.0 overs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bowl.Parse()
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Parse() error = %v, want one containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if tt.bowl.Stats != tt.stats {
+				t.Errorf("Stats = %+v, want %+v", tt.bowl.Stats, tt.stats)
+			}
+		})
+	}
+}
+
+// scorecardInnings returns an innings with two batters making 150 runs
+func scorecardInnings(extras, total, fallOfWickets string) MatchInningsInfo {
+	return MatchInningsInfo{
+		BatsmanDetails: []BatsmanInfo{
+			{Name: "Rohit Sharma", Runs: "100", Balls: "80", Fours: "10", Sixes: "3", StrikeRate: "125.00"},
+			{Name: "Virat Kohli", Runs: "50", Balls: "50", Fours: "4", Sixes: "0", StrikeRate: "100.00"},
+		},
+		Extras:        extras,
+		Total:         total,
+		FallOfWickets: fallOfWickets,
+	}
+}
+
+func TestMatchInningsInfoParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		innings  MatchInningsInfo
+		totals   InningsTotals
+		fow      []FallOfWicket
+		warnings []string
+	}{
+		{
+			name:    "totals add up",
+			innings: scorecardInnings("12 (b 1, lb 4, w 6, nb 1)", "162 (1 wkts, 21.4 Ov)", "48-1 (Rohit Sharma, 7.3)"),
+			totals:  InningsTotals{Extras: 12, Runs: 162, Wickets: 1, Overs: Overs{Balls: 130}},
+			fow:     []FallOfWicket{{Wicket: 1, Runs: 48, Batter: "Rohit Sharma", Overs: Overs{Balls: 45}}},
+		},
+		{
+			name:     "mismatched total",
+			innings:  scorecardInnings("12 (b 1, lb 4, w 6, nb 1)", "170 (1 wkts, 21.4 Ov)", ""),
+			totals:   InningsTotals{Extras: 12, Runs: 170, Wickets: 1, Overs: Overs{Balls: 130}},
+			warnings: []string{"batting runs plus 12 extras make 162, but the total is 170"},
+		},
+		{
+			name:     "total leaves out the extras",
+			innings:  scorecardInnings("12 (b 1, lb 4, w 6, nb 1)", "150 (1 wkts, 21.4 Ov)", ""),
+			totals:   InningsTotals{Extras: 12, Runs: 150, Wickets: 1, Overs: Overs{Balls: 130}},
+			warnings: []string{"batting runs plus 12 extras make 162, but the total is 150"},
+		},
+		{
+			name:     "malformed fall of wickets",
+			innings:  scorecardInnings("12", "162 (1 wkts, 21.4 Ov)", "48-1 (Rohit Sharma, 7.8)"),
+			totals:   InningsTotals{Extras: 12, Runs: 162, Wickets: 1, Overs: Overs{Balls: 130}},
+			warnings: []string{`fall of wickets: invalid overs "7.8"`},
+		},
+		{
+			name:     "fall of wickets out of order",
+			innings:  scorecardInnings("12", "162 (2 wkts, 21.4 Ov)", "92-2 (Virat Kohli, 15.1), 48-1 (Rohit Sharma, 7.3)"),
+			totals:   InningsTotals{Extras: 12, Runs: 162, Wickets: 2, Overs: Overs{Balls: 130}},
+			fow:      []FallOfWicket{{Wicket: 2, Runs: 92, Batter: "Virat Kohli", Overs: Overs{Balls: 91}}, {Wicket: 1, Runs: 48, Batter: "Rohit Sharma", Overs: Overs{Balls: 45}}},
+			warnings: []string{"fall of wickets: 48-1 follows 92-2"},
+		},
+		{
+			name:    "empty innings",
+			innings: MatchInningsInfo{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.innings.Parse()
+			if len(tt.innings.Warnings) != len(tt.warnings) {
+				t.Fatalf("Warnings = %q, want %q", tt.innings.Warnings, tt.warnings)
+			}
+			for i, warning := range tt.warnings {
+				if tt.innings.Warnings[i] != warning {
+					t.Errorf("Warnings[%d] = %q, want %q", i, tt.innings.Warnings[i], warning)
+				}
+			}
+			if tt.innings.Totals != tt.totals {
+				t.Errorf("Totals = %+v, want %+v", tt.innings.Totals, tt.totals)
+			}
+			if tt.fow != nil {
+				if len(tt.innings.FOW) != len(tt.fow) {
+					t.Fatalf("FOW = %+v, want %+v", tt.innings.FOW, tt.fow)
+				}
+				for i := range tt.fow {
+					if tt.innings.FOW[i] != tt.fow[i] {
+						t.Errorf("FOW[%d] = %+v, want %+v", i, tt.innings.FOW[i], tt.fow[i])
+					}
+				}
+			}
+		})
+	}
+}
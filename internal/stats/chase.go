@@ -0,0 +1,71 @@
+package stats
+
+import "github.com/yannlawrency/crictty/internal/models"
+
+// trendOvers is how many overs back the chase trend looks
+const trendOvers = 5
+
+// Chase is the equation of a limited-overs chase
+type Chase struct {
+	Target       int
+	Runs         int
+	Needed       int
+	BallsLeft    int
+	WicketsLeft  int
+	RequiredRate float64
+	CurrentRate  float64
+
+	// The required rate and runs scored five overs ago, when the timeline
+	// reaches back that far
+	HasTrend         bool
+	PastRequiredRate float64
+	RecentRuns       int
+}
+
+// ComputeChase works out the chase equation of a limited-overs match in its
+// second innings from the innings scores and the match format alone, so it
+// does not rely on the required rate or overs left sent by Cricbuzz. The
+// target is revised by DLS when the chase was reduced.
+func ComputeChase(match models.MatchInfo, timeline Timeline) (Chase, bool) {
+	format := match.CricbuzzInfo.MatchHeader.MatchFormat
+	innings := inningsList(match)
+	if !IsLimitedOvers(format) || len(innings) != 2 {
+		return Chase{}, false
+	}
+
+	first, second := innings[0], innings[1]
+	perOver := perOver(match)
+	chase := Chase{
+		Target:      int(first.Score) + 1,
+		Runs:        int(second.Score),
+		WicketsLeft: 10 - int(second.Wickets),
+	}
+	if par, ok := ComputeParScore(match, timeline); ok {
+		chase.Target = par.Target
+	}
+	chase.Needed = max(chase.Target-chase.Runs, 0)
+
+	// Prefer the overs left from Cricbuzz as they account for reduced overs
+	if oversRem := match.CricbuzzInfo.Miniscore.OversRem; oversRem != nil && oversRem.Balls > 0 {
+		chase.BallsLeft = oversRem.Balls
+	} else {
+		chase.BallsLeft = max(InningsBalls(format)-second.Overs.Balls, 0)
+	}
+
+	chase.CurrentRate = rate(chase.Runs, second.Overs.Balls, perOver)
+	chase.RequiredRate = rate(chase.Needed, chase.BallsLeft, perOver)
+
+	// Compare with the equation five overs ago
+	if second.Overs.Balls >= trendOvers*perOver {
+		past, ok := timeline.At(second.InningsID, second.Overs.Balls-trendOvers*perOver)
+		if ok && second.Overs.Balls-past.Balls <= (trendOvers+1)*perOver {
+			pastNeeded := max(chase.Target-past.Runs, 0)
+			pastBallsLeft := chase.BallsLeft + second.Overs.Balls - past.Balls
+			chase.HasTrend = true
+			chase.PastRequiredRate = rate(pastNeeded, pastBallsLeft, perOver)
+			chase.RecentRuns = chase.Runs - past.Runs
+		}
+	}
+
+	return chase, true
+}
@@ -0,0 +1,256 @@
+package stats
+
+import (
+	"cmp"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// playerRole pattern matches the captain and keeper marks after a name
+var playerRole = regexp.MustCompile(`\s*\([^)]*\)`)
+
+// BatterControl is how freely a batter has been scoring
+type BatterControl struct {
+	Minutes int
+	Balls   int
+	Dots    int
+	Fours   int
+	Sixes   int
+
+	// DotBalls is how many of the balls faced Dots covers, fewer than Balls
+	// when the dot balls were counted from commentary that missed part of
+	// the innings
+	DotBalls int
+
+	// Whether the minutes and dot balls are known
+	HasMinutes bool
+	HasDots    bool
+
+	// Balls and dot balls counted from the commentary, and the figures
+	// after the last ball seen to tell what the next one scored
+	counted     int
+	countedDots int
+	hasLast     bool
+	lastBalls   int
+	lastRuns    int
+}
+
+// BatterControlOf returns the control stats of a batter from the miniscore
+// or commentary figures. Dot balls are only known when the figures carry them.
+func BatterControlOf(b models.Batsman) BatterControl {
+	c := BatterControl{
+		Minutes:    int(b.BatMins),
+		Balls:      int(b.BatBalls),
+		Fours:      int(b.BatFours),
+		Sixes:      int(b.BatSixes),
+		HasMinutes: b.BatMins > 0,
+	}
+	if b.BatDots != nil && b.BatBalls > 0 {
+		c.Dots, c.DotBalls, c.HasDots = int(*b.BatDots), c.Balls, true
+	}
+	return c
+}
+
+// add takes the latest figures of a batter, counting the ball just faced
+// when it follows on from the last ball seen or opens the innings. The dot
+// balls counted stand in when the figures do not carry any.
+func (c BatterControl) add(b models.Batsman) BatterControl {
+	balls, runs := int(b.BatBalls), int(b.BatRuns)
+	follows := c.hasLast && balls == c.lastBalls+1
+	opens := !c.hasLast && balls == 1
+	if follows || opens {
+		c.counted++
+		if runs == c.lastRuns {
+			c.countedDots++
+		}
+	}
+
+	next := BatterControlOf(b)
+	if !next.HasDots && c.counted > 0 {
+		next.Dots, next.DotBalls, next.HasDots = c.countedDots, c.counted, true
+	}
+	next.counted, next.countedDots = c.counted, c.countedDots
+	next.hasLast, next.lastBalls, next.lastRuns = true, balls, runs
+	return next
+}
+
+// Partial reports whether the dot balls cover only part of the balls faced
+func (c BatterControl) Partial() bool {
+	return c.HasDots && c.DotBalls < c.Balls
+}
+
+// DotPercent returns the share of balls faced that were not scored off
+func (c BatterControl) DotPercent() float64 {
+	return percent(c.Dots, c.DotBalls)
+}
+
+// BoundaryPercent returns the share of balls faced that went for four or six
+func (c BatterControl) BoundaryPercent() float64 {
+	return percent(c.Fours+c.Sixes, c.Balls)
+}
+
+// ScoringShotPercent returns the share of balls faced that were scored off
+func (c BatterControl) ScoringShotPercent() float64 {
+	return percent(c.DotBalls-c.Dots, c.DotBalls)
+}
+
+// BowlerControl counts the dot balls of a bowler seen in the commentary
+type BowlerControl struct {
+	Balls int
+	Dots  int
+
+	// Figures after the last ball seen, to tell what the next one conceded
+	hasLast   bool
+	lastBalls int
+	lastRuns  int
+}
+
+// DotPercent returns the share of the balls seen that conceded nothing
+func (c BowlerControl) DotPercent() float64 {
+	return percent(c.Dots, c.Balls)
+}
+
+// playerKey identifies a player in an innings
+type playerKey struct {
+	inningsID uint32
+	name      string
+}
+
+// Control gathers the control stats of the players of a match. The
+// commentary of a snapshot only covers the latest balls, so dot balls of
+// bowlers, and of batters whose figures leave them out, are counted as
+// snapshots are added and may cover only part of a spell or innings that
+// started before the first snapshot.
+type Control struct {
+	batters map[playerKey]BatterControl
+	bowlers map[playerKey]BowlerControl
+	seen    map[int64]bool
+}
+
+// Add returns the control stats with the commentary and current batters of
+// a snapshot counted in. Commentary entries already counted are skipped.
+func (c Control) Add(match models.MatchInfo) Control {
+	if c.seen == nil {
+		c.batters = make(map[playerKey]BatterControl)
+		c.bowlers = make(map[playerKey]BowlerControl)
+		c.seen = make(map[int64]bool)
+	}
+
+	// Commentary arrives newest first
+	entries := slices.Clone(match.CricbuzzInfo.CommentaryList)
+	slices.SortFunc(entries, func(a, b models.Commentary) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+	for _, entry := range entries {
+		if entry.BallNbr == 0 || c.seen[entry.Timestamp] {
+			continue
+		}
+		c.seen[entry.Timestamp] = true
+
+		if bat := entry.BatsmanStriker; bat.BatID != 0 {
+			key := playerKey{entry.InningsID, playerName(bat.BatName)}
+			c.batters[key] = c.batters[key].add(bat)
+		}
+		if bowl := entry.BowlerStriker; bowl.BowlID != 0 {
+			key := playerKey{entry.InningsID, playerName(bowl.BowlName)}
+			c.bowlers[key] = c.bowlers[key].add(bowl)
+		}
+	}
+
+	// The miniscore has the latest figures of the batters in
+	miniscore := match.CricbuzzInfo.Miniscore
+	for _, bat := range []models.Batsman{miniscore.BatsmanStriker, miniscore.BatsmanNonStriker} {
+		if bat.BatID != 0 {
+			key := playerKey{miniscore.InningsID, playerName(bat.BatName)}
+			c.batters[key] = c.batters[key].add(bat)
+		}
+	}
+	return c
+}
+
+// Clone returns a copy of the control stats that later calls to Add leave
+// untouched
+func (c Control) Clone() Control {
+	return Control{
+		batters: maps.Clone(c.batters),
+		bowlers: maps.Clone(c.bowlers),
+		seen:    maps.Clone(c.seen),
+	}
+}
+
+// add counts the ball a bowler has just bowled, when it follows on from the
+// last ball seen or opens the spell
+func (c BowlerControl) add(bowl models.Bowler) BowlerControl {
+	balls, runs := bowl.BowlOvs.Balls, int(bowl.BowlRuns)
+	follows := c.hasLast && balls == c.lastBalls+1
+	opens := !c.hasLast && balls == 1
+	if follows || opens {
+		c.Balls++
+		if runs == c.lastRuns {
+			c.Dots++
+		}
+	}
+	c.hasLast, c.lastBalls, c.lastRuns = true, balls, runs
+	return c
+}
+
+// Batter returns the control stats of a batter in an innings
+func (c Control) Batter(inningsID uint32, name string) (BatterControl, bool) {
+	key, ok := findPlayer(c.batters, inningsID, name)
+	return c.batters[key], ok
+}
+
+// Bowler returns the dot balls of a bowler in an innings
+func (c Control) Bowler(inningsID uint32, name string) (BowlerControl, bool) {
+	key, ok := findPlayer(c.bowlers, inningsID, name)
+	return c.bowlers[key], ok && c.bowlers[key].Balls > 0
+}
+
+// findPlayer looks a player up by name, falling back to the surname when
+// the scorecard and the commentary spell the name differently
+func findPlayer[T any](players map[playerKey]T, inningsID uint32, name string) (playerKey, bool) {
+	name = playerName(name)
+	key := playerKey{inningsID, name}
+	if _, ok := players[key]; ok {
+		return key, true
+	}
+
+	surname := name[strings.LastIndex(name, " ")+1:]
+	var found []playerKey
+	for other := range players {
+		if other.inningsID == inningsID && strings.HasSuffix(other.name, surname) {
+			found = append(found, other)
+		}
+	}
+	if len(found) != 1 || surname == "" {
+		return playerKey{}, false
+	}
+	return found[0], true
+}
+
+// samePlayer reports whether two names are the same player, comparing
+// surnames when one of them is shortened
+func samePlayer(a, b string) bool {
+	a, b = playerName(a), playerName(b)
+	if a == b {
+		return true
+	}
+	return a[strings.LastIndex(a, " ")+1:] == b[strings.LastIndex(b, " ")+1:]
+}
+
+// playerName normalises a name for lookups, without role marks or case
+func playerName(name string) string {
+	return strings.ToLower(strings.TrimSpace(playerRole.ReplaceAllString(name, "")))
+}
+
+// percent returns part as a percentage of whole
+func percent(part, whole int) float64 {
+	if whole <= 0 {
+		return 0
+	}
+	return 100 * float64(part) / float64(whole)
+}
@@ -0,0 +1,181 @@
+package stats
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// sessionPattern finds the session Cricbuzz names in a status, like
+// "Day 2: Session 3"
+var sessionPattern = regexp.MustCompile(`(?i)session (\d)`)
+
+// breaks are the intervals of a multi-day match, as named in Cricbuzz states
+// and statuses. Every break but stumps ends a session.
+var breaks = []string{"stumps", "lunch", "tea", "dinner"}
+
+// sessionOf returns the session named in any of the statuses, or zero
+func sessionOf(statuses ...string) int {
+	for _, status := range statuses {
+		if found := sessionPattern.FindStringSubmatch(status); found != nil {
+			n, _ := strconv.Atoi(found[1])
+			return n
+		}
+	}
+	return 0
+}
+
+// breakOf returns the break play is in from the match state or statuses,
+// or an empty string while play is on
+func breakOf(state string, statuses ...string) string {
+	for _, text := range append([]string{state}, statuses...) {
+		text = strings.ToLower(text)
+		for _, name := range breaks {
+			if strings.Contains(text, name) {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// Session is the runs and wickets of a session of a multi-day match
+type Session struct {
+	Number  int
+	Runs    int
+	Wickets int
+	Balls   int
+}
+
+// Day is the summary of a day of a multi-day match
+type Day struct {
+	Number   int
+	Sessions []Session
+	Runs     int
+	Wickets  int
+	Balls    int
+
+	// End is the last point of the day, the stumps score once it is over
+	End Point
+
+	// Partial is set when the start of the day was not seen, so the runs,
+	// wickets and balls only count from the first point of it
+	Partial bool
+}
+
+// DayState is where play stands on the current day
+type DayState struct {
+	Day        int
+	Session    int
+	Break      string
+	BallsToday int
+	DayNight   bool
+
+	// Partial is set when the start of the day was not seen, so BallsToday
+	// only counts from the first point of it
+	Partial bool
+}
+
+// matchProgress returns the runs, wickets and balls of the whole match at a
+// point, adding the final scores of the innings before it
+func matchProgress(innings []models.InningsScore, p Point) (runs, wickets, balls int) {
+	for _, inn := range innings {
+		if inn.InningsID < p.InningsID {
+			runs += int(inn.Score)
+			wickets += int(inn.Wickets)
+			balls += inn.Overs.Balls
+		}
+	}
+	return runs + p.Runs, wickets + p.Wickets, balls + p.Balls
+}
+
+// Days summarises every day of a multi-day match seen in the timeline,
+// splitting each day into sessions at the lunch, tea or dinner breaks
+func Days(match models.MatchInfo, timeline Timeline) []Day {
+	if IsLimitedOvers(match.CricbuzzInfo.MatchHeader.MatchFormat) {
+		return nil
+	}
+	innings := inningsList(match)
+
+	var days []Day
+	var dayStart, sessionStart Point
+	inBreak := false
+	for _, p := range timeline {
+		if p.Day == 0 {
+			continue
+		}
+
+		// A new day starts from stumps the day before, or from the first
+		// point seen of it when the day before was missed
+		if len(days) == 0 || days[len(days)-1].Number != p.Day {
+			dayStart = p
+			partial := p.Day != 1 || p.InningsID > 1 || p.Runs != 0 || p.Balls != 0
+			if len(days) > 0 && days[len(days)-1].Number == p.Day-1 {
+				dayStart = days[len(days)-1].End
+				partial = false
+			}
+			sessionStart = dayStart
+			days = append(days, Day{Number: p.Day, Sessions: []Session{{Number: 1}}, Partial: partial})
+			inBreak = false
+		}
+		day := &days[len(days)-1]
+
+		// Play after a break, or a session Cricbuzz names, starts the next
+		// session from the score at the break
+		isBreak := p.Break != ""
+		last := day.Sessions[len(day.Sessions)-1].Number
+		switch {
+		case p.Session > last:
+			day.Sessions = append(day.Sessions, Session{Number: p.Session})
+			sessionStart = day.End
+		case inBreak && !isBreak && p.Session == 0:
+			day.Sessions = append(day.Sessions, Session{Number: last + 1})
+			sessionStart = day.End
+		}
+		inBreak = isBreak
+
+		runs, wickets, balls := matchProgress(innings, p)
+		startRuns, startWickets, startBalls := matchProgress(innings, dayStart)
+		sessionRuns, sessionWickets, sessionBalls := matchProgress(innings, sessionStart)
+		day.Sessions[len(day.Sessions)-1] = Session{
+			Number:  day.Sessions[len(day.Sessions)-1].Number,
+			Runs:    runs - sessionRuns,
+			Wickets: wickets - sessionWickets,
+			Balls:   balls - sessionBalls,
+		}
+		day.Runs = runs - startRuns
+		day.Wickets = wickets - startWickets
+		day.Balls = balls - startBalls
+		day.End = p
+	}
+	return days
+}
+
+// CurrentDay returns the day and session of a multi-day match and how many
+// balls have been bowled on the day
+func CurrentDay(match models.MatchInfo, timeline Timeline) (DayState, bool) {
+	header := match.CricbuzzInfo.MatchHeader
+	if header.DayNumber == nil || IsLimitedOvers(header.MatchFormat) {
+		return DayState{}, false
+	}
+
+	state := DayState{
+		Day:      int(*header.DayNumber),
+		Session:  1,
+		Break:    breakOf(header.State, match.CricbuzzInfo.Miniscore.Status, header.Status),
+		DayNight: header.DayNight != nil && *header.DayNight,
+	}
+	days := Days(match, timeline)
+	if len(days) > 0 && days[len(days)-1].Number == state.Day {
+		today := days[len(days)-1]
+		state.Session = today.Sessions[len(today.Sessions)-1].Number
+		state.BallsToday = today.Balls
+		state.Partial = today.Partial
+	}
+	if session := sessionOf(match.CricbuzzInfo.Miniscore.Status, header.Status); session > 0 {
+		state.Session = session
+	}
+	return state, true
+}
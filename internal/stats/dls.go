@@ -0,0 +1,204 @@
+package stats
+
+import (
+	_ "embed"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// dlsG50 is the average first innings total of the Standard Edition, used
+// to scale the target up when the chasing side has more resources
+const dlsG50 = 245
+
+// dlsMaxOvers is the number of overs covered by the resource table
+const dlsMaxOvers = 50
+
+//go:embed dls_standard.txt
+var dlsStandard string
+
+// dlsTable holds the resources left by overs left and wickets lost
+var dlsTable = parseResourceTable(dlsStandard)
+
+// reducedOversPattern finds the overs an innings was cut to in a status
+var reducedOversPattern = regexp.MustCompile(`(?i)reduced to (\d+) overs`)
+
+// parseResourceTable parses the embedded resource table, one row of
+// resources per overs left
+func parseResourceTable(data string) [dlsMaxOvers + 1][10]float64 {
+	var table [dlsMaxOvers + 1][10]float64
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 11 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		overs, err := strconv.Atoi(fields[0])
+		if err != nil || overs < 0 || overs > dlsMaxOvers {
+			panic("stats: bad DLS resource table row: " + line)
+		}
+		for w, field := range fields[1:] {
+			resource, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				panic("stats: bad DLS resource table row: " + line)
+			}
+			table[overs][w] = resource
+		}
+	}
+	return table
+}
+
+// Resources returns the percentage of a full innings' run-scoring resources
+// left with a number of legal balls to come and wickets lost. Part overs are
+// interpolated between the rows of the table.
+func Resources(ballsLeft, wicketsLost int) float64 {
+	if ballsLeft <= 0 || wicketsLost >= 10 {
+		return 0
+	}
+	wicketsLost = max(wicketsLost, 0)
+	overs, balls := ballsLeft/6, ballsLeft%6
+	if overs >= dlsMaxOvers {
+		return dlsTable[dlsMaxOvers][wicketsLost]
+	}
+	low, high := dlsTable[overs][wicketsLost], dlsTable[overs+1][wicketsLost]
+	return low + (high-low)*float64(balls)/6
+}
+
+// ParScore is the DLS state of a limited-overs chase with reduced overs
+type ParScore struct {
+	// Legal balls the chasing side has in total after the reduction
+	Balls int
+
+	// Resources available to each side
+	FirstResources  float64
+	SecondResources float64
+
+	// Target is the revised target, Par the score the chasing side needs
+	// now to be level if no more play were possible
+	Target int
+	Par    int
+	Runs   int
+}
+
+// Ahead returns how many runs the chasing side is ahead of par, negative
+// when it is behind
+func (p ParScore) Ahead() int {
+	return p.Runs - p.Par
+}
+
+// ComputeParScore works out the DLS revised target and current par score of
+// a one-day or T20 chase whose overs were reduced. Overs lost are taken to
+// be lost at the first point of the timeline showing each cut, or at the
+// current point when the timeline does not show it yet, so the target stays
+// put as the chase goes on. A first innings that ended early without being
+// bowled out is taken to have been reduced from the start.
+func ComputeParScore(match models.MatchInfo, timeline Timeline) (ParScore, bool) {
+	format := match.CricbuzzInfo.MatchHeader.MatchFormat
+	full := InningsBalls(format)
+	innings := inningsList(match)
+	if full == 0 || full > dlsMaxOvers*6 || perOver(match) != 6 || len(innings) != 2 {
+		return ParScore{}, false
+	}
+
+	first, second := innings[0], innings[1]
+	bowled := second.Overs.Balls
+	allotted := chaseBalls(match, bowled)
+	if allotted == 0 || allotted >= full {
+		return ParScore{}, false
+	}
+
+	firstResources := Resources(full, 0)
+	if first.Wickets < 10 && first.Overs.Balls < full {
+		firstResources = Resources(first.Overs.Balls, 0)
+	}
+
+	// Resources of a full chase less those lost at each cut
+	wickets := int(second.Wickets)
+	cuts := oversCuts(timeline.Innings(second.InningsID), full)
+	if len(cuts) == 0 || cuts[len(cuts)-1].after != allotted {
+		before := full
+		if len(cuts) > 0 {
+			before = cuts[len(cuts)-1].after
+		}
+		cuts = append(cuts, oversCut{balls: bowled, wickets: wickets, before: before, after: allotted})
+	}
+	lost := 0.0
+	for _, cut := range cuts {
+		lost += Resources(cut.before-cut.balls, cut.wickets) - Resources(cut.after-cut.balls, cut.wickets)
+	}
+	par := ParScore{
+		Balls:           allotted,
+		FirstResources:  firstResources,
+		SecondResources: Resources(full, 0) - lost,
+		Runs:            int(second.Score),
+	}
+	if par.FirstResources <= 0 {
+		return ParScore{}, false
+	}
+
+	score := func(resources float64) float64 {
+		if resources <= par.FirstResources {
+			return float64(first.Score) * resources / par.FirstResources
+		}
+		return float64(first.Score) + dlsG50*(resources-par.FirstResources)/100
+	}
+	par.Target = int(math.Floor(score(par.SecondResources))) + 1
+	used := par.SecondResources - Resources(allotted-bowled, wickets)
+	par.Par = int(math.Floor(score(used)))
+
+	return par, true
+}
+
+// oversCut is a point of an innings where its overs were reduced
+type oversCut struct {
+	// Legal balls bowled and wickets lost when the overs were cut
+	balls   int
+	wickets int
+
+	// Legal balls the innings had before and after the cut
+	before int
+	after  int
+}
+
+// oversCuts returns the points of an innings where the timeline first shows
+// each reduction of its overs
+func oversCuts(points Timeline, full int) []oversCut {
+	var cuts []oversCut
+	allotted := full
+	for _, p := range points {
+		if p.Allotted == 0 || p.Allotted == allotted {
+			continue
+		}
+		cuts = append(cuts, oversCut{balls: p.Balls, wickets: p.Wickets, before: allotted, after: p.Allotted})
+		allotted = p.Allotted
+	}
+	return cuts
+}
+
+// reducedBalls returns the legal balls an innings was cut to, or zero when
+// its overs were not reduced
+func reducedBalls(match models.MatchInfo, bowled int) int {
+	full := InningsBalls(match.CricbuzzInfo.MatchHeader.MatchFormat)
+	if allotted := chaseBalls(match, bowled); allotted > 0 && allotted < full {
+		return allotted
+	}
+	return 0
+}
+
+// chaseBalls returns the legal balls the chasing side has in total, from
+// the overs left sent by Cricbuzz or a "reduced to N overs" status
+func chaseBalls(match models.MatchInfo, bowled int) int {
+	miniscore := match.CricbuzzInfo.Miniscore
+	if miniscore.OversRem != nil && miniscore.OversRem.Balls > 0 {
+		return bowled + miniscore.OversRem.Balls
+	}
+	for _, status := range []string{miniscore.Status, match.CricbuzzInfo.MatchHeader.Status} {
+		if found := reducedOversPattern.FindStringSubmatch(status); found != nil {
+			overs, _ := strconv.Atoi(found[1])
+			return overs * 6
+		}
+	}
+	return 0
+}
@@ -0,0 +1,66 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// reducedChase returns an ODI snapshot chasing 251 with the chase cut to
+// 40 overs
+func reducedChase(runs, wickets, balls int) models.MatchInfo {
+	match := winMatch("ODI",
+		models.InningsScore{InningsID: 1, BatTeamID: 1, BatTeamName: "IND", Score: 250, Wickets: 10, Overs: models.BallsToOvers(300, 6)},
+		models.InningsScore{InningsID: 2, BatTeamID: 2, BatTeamName: "AUS", Score: uint32(runs), Wickets: uint32(wickets), Overs: models.BallsToOvers(balls, 6)})
+	miniscore := &match.CricbuzzInfo.Miniscore
+	miniscore.InningsID = 2
+	oversRem := models.BallsToOvers(40*6-balls, 6)
+	miniscore.OversRem = &oversRem
+	return match
+}
+
+func TestComputeParScoreKeepsTarget(t *testing.T) {
+	// Overs are cut at 100/2 after 20 overs
+	before := reducedChase(90, 2, 108)
+	before.CricbuzzInfo.Miniscore.OversRem = nil
+	cut := reducedChase(100, 2, 120)
+	timeline := Timeline{}.Add(before).Add(cut)
+	want, ok := ComputeParScore(cut, timeline)
+	if !ok {
+		t.Fatal("ComputeParScore() ok = false at the cut, want true")
+	}
+	if want.Target != 213 {
+		t.Errorf("Target at the cut = %d, want 213", want.Target)
+	}
+
+	later := []struct {
+		name    string
+		runs    int
+		wickets int
+		balls   int
+		par     int
+	}{
+		{"120/2 after 25 overs", 120, 2, 150, 106},
+		{"120/6 after 25 overs", 120, 6, 150, 143},
+		{"160/8 after 33.2 overs", 160, 8, 200, 186},
+	}
+	for _, tt := range later {
+		t.Run(tt.name, func(t *testing.T) {
+			match := reducedChase(tt.runs, tt.wickets, tt.balls)
+			timeline := timeline.Add(match)
+			got, ok := ComputeParScore(match, timeline)
+			if !ok {
+				t.Fatal("ComputeParScore() ok = false, want true")
+			}
+			if got.Target != want.Target {
+				t.Errorf("Target = %d, want %d as at the cut", got.Target, want.Target)
+			}
+			if got.Par != tt.par {
+				t.Errorf("Par = %d, want %d", got.Par, tt.par)
+			}
+			if chase, _ := ComputeChase(match, timeline); chase.Target != want.Target {
+				t.Errorf("ComputeChase() Target = %d, want %d", chase.Target, want.Target)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package stats
+
+import (
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// InningsBalls returns the number of legal balls in a full innings of a
+// match format, or zero for formats without an overs limit
+func InningsBalls(format string) int {
+	switch f := strings.ToUpper(format); {
+	case strings.Contains(f, "HUNDRED"):
+		return 100
+	case strings.Contains(f, "T20"):
+		return 20 * 6
+	case strings.Contains(f, "T10"):
+		return 10 * 6
+	case strings.Contains(f, "ODI"), strings.Contains(f, "LIST A"):
+		return 50 * 6
+	default:
+		return 0
+	}
+}
+
+// IsLimitedOvers reports whether innings of the format have an overs limit
+func IsLimitedOvers(format string) bool {
+	return InningsBalls(format) > 0
+}
+
+// inningsList returns the innings of a match in the order they were played
+func inningsList(match models.MatchInfo) []models.InningsScore {
+	return match.CricbuzzInfo.Miniscore.MatchScoreDetails.Innings()
+}
+
+// perOver returns the number of balls per over of a match
+func perOver(match models.MatchInfo) int {
+	return models.BallsPerOver(match.CricbuzzInfo.MatchHeader.MatchFormat)
+}
+
+// rate returns runs per over from runs scored off a number of legal balls
+func rate(runs, balls, perOver int) float64 {
+	if balls <= 0 {
+		return 0
+	}
+	return float64(runs) * float64(perOver) / float64(balls)
+}
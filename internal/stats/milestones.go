@@ -0,0 +1,145 @@
+package stats
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// milestoneWindow is how many runs short of a landmark a batter or
+// partnership is flagged
+const milestoneWindow = 10
+
+// Landmarks watched for batters, bowlers and partnerships
+var (
+	battingLandmarks     = []int{50, 100, 150, 200}
+	bowlingHauls         = []int{3, 4, 5}
+	partnershipLandmarks = []int{50, 100}
+)
+
+// Milestone is a personal or partnership landmark about to be reached
+type Milestone struct {
+	Who     string
+	Current int
+	Target  int
+
+	// Wickets is set for bowlers, the rest count runs
+	Wickets bool
+}
+
+// String describes the milestone, e.g. "Kohli 94 (6 to 100)"
+func (m Milestone) String() string {
+	if m.Wickets {
+		return fmt.Sprintf("%s %d wkts (1 from %d-for)", m.Who, m.Current, m.Target)
+	}
+	return fmt.Sprintf("%s %d (%d to %d)", m.Who, m.Current, m.Target-m.Current, m.Target)
+}
+
+// Milestones returns the landmarks the batters in, the bowlers and the
+// current partnership of the innings in progress are close to
+func Milestones(match models.MatchInfo) []Milestone {
+	if match.IsFinished() {
+		return nil
+	}
+	miniscore := match.CricbuzzInfo.Miniscore
+	scorecard, hasScorecard := currentScorecard(match)
+
+	var milestones []Milestone
+
+	// Batters in, from the miniscore or the not out batters of the scorecard
+	batters := map[string]int{}
+	for _, bat := range []models.Batsman{miniscore.BatsmanStriker, miniscore.BatsmanNonStriker} {
+		if bat.BatName != "" {
+			batters[bat.BatName] = int(bat.BatRuns)
+		}
+	}
+	if len(batters) == 0 && hasScorecard {
+		for _, bat := range scorecard.BatsmanDetails {
+			status := strings.ToLower(bat.Status)
+			if strings.Contains(status, "not out") || status == "batting" {
+				batters[bat.Name] = bat.Stats.Runs
+			}
+		}
+	}
+	for name, runs := range batters {
+		if target, ok := nextLandmark(runs, battingLandmarks, milestoneWindow); ok {
+			milestones = append(milestones, Milestone{Who: name, Current: runs, Target: target})
+		}
+	}
+
+	// Every bowler of the innings may come back on, the current ones have
+	// the latest figures
+	bowlers := map[string]int{}
+	if hasScorecard {
+		for _, bowl := range scorecard.BowlerDetails {
+			bowlers[bowl.Name] = bowl.Stats.Wickets
+		}
+	}
+	for _, bowl := range []models.Bowler{miniscore.BowlerStriker, miniscore.BowlerNonStriker} {
+		if bowl.BowlName == "" {
+			continue
+		}
+		for name := range bowlers {
+			if samePlayer(name, bowl.BowlName) {
+				delete(bowlers, name)
+			}
+		}
+		bowlers[bowl.BowlName] = int(bowl.BowlWkts)
+	}
+	for name, wickets := range bowlers {
+		if target, ok := nextLandmark(wickets, bowlingHauls, 1); ok {
+			milestones = append(milestones, Milestone{Who: name, Current: wickets, Target: target, Wickets: true})
+		}
+	}
+
+	// Current partnership
+	stand := int(miniscore.PartnerShip.Runs)
+	if target, ok := nextLandmark(stand, partnershipLandmarks, milestoneWindow); ok {
+		milestones = append(milestones, Milestone{Who: "Partnership", Current: stand, Target: target})
+	}
+
+	sortMilestones(milestones)
+	return milestones
+}
+
+// nextLandmark returns the first landmark above current when it is at most
+// window away
+func nextLandmark(current int, landmarks []int, window int) (int, bool) {
+	for _, landmark := range landmarks {
+		if landmark > current {
+			return landmark, landmark-current <= window
+		}
+	}
+	return 0, false
+}
+
+// sortMilestones orders milestones runs first and closest first, then by
+// name so the line does not shuffle between refreshes
+func sortMilestones(milestones []Milestone) {
+	slices.SortFunc(milestones, func(a, b Milestone) int {
+		if a.Wickets != b.Wickets {
+			if a.Wickets {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(
+			cmp.Compare(a.Target-a.Current, b.Target-b.Current),
+			cmp.Compare(a.Who, b.Who),
+		)
+	})
+}
+
+// currentScorecard returns the scorecard of the innings in progress
+func currentScorecard(match models.MatchInfo) (models.MatchInningsInfo, bool) {
+	innings := inningsList(match)
+	for i, inn := range innings {
+		if inn.InningsID == match.CricbuzzInfo.Miniscore.InningsID && i < len(match.Scorecard) {
+			return match.Scorecard[i], true
+		}
+	}
+	return models.MatchInningsInfo{}, false
+}
@@ -0,0 +1,62 @@
+package stats
+
+// Over is the runs and wickets of a single over of an innings
+type Over struct {
+	Number   int
+	Runs     int
+	Wickets  int
+	Total    int
+	Complete bool
+}
+
+// OverByOver builds the runs and wickets of every over of an innings from
+// the points of a timeline. Snapshots rarely land on the last ball of an
+// over, so the score at the end of an over is interpolated between the
+// points around it.
+func OverByOver(timeline Timeline, inningsID uint32, perOver int) []Over {
+	points := append(Timeline{{InningsID: inningsID}}, timeline.Innings(inningsID)...)
+	last := points[len(points)-1]
+	if last.Balls == 0 || perOver <= 0 {
+		return nil
+	}
+
+	// Score and wickets after a number of balls
+	scoreAt := func(balls int) (int, int) {
+		before, after := points[0], last
+		for _, p := range points {
+			if p.Balls <= balls {
+				before = p
+			}
+		}
+		for i := len(points) - 1; i >= 0; i-- {
+			if points[i].Balls >= balls {
+				after = points[i]
+			}
+		}
+		if after.Balls == before.Balls {
+			return before.Runs, before.Wickets
+		}
+		share := float64(balls-before.Balls) / float64(after.Balls-before.Balls)
+		return before.Runs + int(share*float64(after.Runs-before.Runs)+0.5), before.Wickets
+	}
+
+	var overs []Over
+	total, wickets := 0, 0
+	for n := 1; (n-1)*perOver < last.Balls; n++ {
+		end := min(n*perOver, last.Balls)
+		runs, down := scoreAt(end)
+		if end == last.Balls {
+			runs, down = last.Runs, last.Wickets
+		}
+		runs, down = max(runs, total), max(down, wickets)
+		overs = append(overs, Over{
+			Number:   n,
+			Runs:     runs - total,
+			Wickets:  down - wickets,
+			Total:    runs,
+			Complete: n*perOver <= last.Balls,
+		})
+		total, wickets = runs, down
+	}
+	return overs
+}
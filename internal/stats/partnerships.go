@@ -0,0 +1,212 @@
+package stats
+
+import (
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// Partnership is the stand between two batters for a wicket
+type Partnership struct {
+	// Wicket is the wicket the stand was for, 1 for the opening stand
+	Wicket  int
+	Batters [2]string
+
+	// Runs scored by each batter, -1 when it cannot be worked out
+	Runs  [2]int
+	Total int
+	Balls int
+
+	// EndedBy is the batter dismissed at the end of the stand, empty while
+	// it is unbroken
+	EndedBy string
+}
+
+// Unbroken reports whether the stand is still going or ended the innings
+func (p Partnership) Unbroken() bool {
+	return p.EndedBy == ""
+}
+
+// Partnerships works out the stands of a scorecard innings from the fall of
+// wickets and the batting order. The runs of each batter are exact when the
+// batter only took part in one stand, and otherwise come from the scores of
+// the batters in at the points of the timeline.
+func Partnerships(innings models.MatchInningsInfo, inningsID uint32, timeline Timeline) []Partnership {
+	order := innings.BatsmanDetails
+	if len(order) < 2 {
+		return nil
+	}
+
+	// Replay the batting order, the batter out at each wicket making way
+	// for the next one in. A batter who retired also counts the stand they
+	// left during, so their runs are not all given to the one before.
+	var stands []Partnership
+	pair := [2]int{0, 1}
+	next := 2
+	spans := make([][]int, len(order))
+	runs, balls := 0, 0
+	addStand := func(stand Partnership) {
+		for _, i := range pair {
+			spans[i] = append(spans[i], len(stands))
+		}
+		stand.Batters = [2]string{order[pair[0]].Name, order[pair[1]].Name}
+		stand.Runs = [2]int{-1, -1}
+		stands = append(stands, stand)
+	}
+	for _, fow := range innings.FOW {
+		// A batter out while not in the pair came in for one who retired
+		if !samePlayer(order[pair[0]].Name, fow.Batter) && !samePlayer(order[pair[1]].Name, fow.Batter) {
+			if j, ok := findBatter(order, fow.Batter); ok && j >= next {
+				for slot, i := range pair {
+					if retired(order[i]) {
+						spans[i] = append(spans[i], len(stands))
+						pair[slot] = j
+						next = j + 1
+						break
+					}
+				}
+			}
+		}
+
+		addStand(Partnership{
+			Wicket:  fow.Wicket,
+			Total:   fow.Runs - runs,
+			Balls:   fow.Overs.Balls - balls,
+			EndedBy: fow.Batter,
+		})
+		runs, balls = fow.Runs, fow.Overs.Balls
+
+		out := 1
+		if samePlayer(order[pair[0]].Name, fow.Batter) {
+			out = 0
+		}
+		if next >= len(order) {
+			break
+		}
+		pair[out] = next
+		next++
+	}
+
+	// Batters still to come after the last wicket replaced ones who retired
+	for slot, i := range pair {
+		if retired(order[i]) && next < len(order) {
+			spans[i] = append(spans[i], len(stands))
+			pair[slot] = next
+			next++
+		}
+	}
+
+	// The last stand is unbroken unless the side was bowled out
+	if len(stands) == len(innings.FOW) && innings.Totals.Wickets < 10 && len(innings.FOW) < len(order)-1 {
+		addStand(Partnership{
+			Wicket: len(innings.FOW) + 1,
+			Total:  max(innings.Totals.Runs-runs, 0),
+			Balls:  max(innings.Totals.Overs.Balls-balls, 0),
+		})
+	}
+
+	// Work out the runs of each batter in each stand
+	points := timeline.Innings(inningsID)
+	for s := range stands {
+		stand := &stands[s]
+		var first, last Point
+		found := false
+		for _, p := range points {
+			_, in0 := p.Batter(stand.Batters[0])
+			_, in1 := p.Batter(stand.Batters[1])
+			if in0 && in1 {
+				if !found {
+					first = p
+				}
+				last, found = p, true
+			}
+		}
+
+		for b, name := range stand.Batters {
+			i := battingIndex(order, name)
+			span := spans[i]
+			starts, ends := span[0] == s, span[len(span)-1] == s
+			switch {
+			case starts && ends:
+				stand.Runs[b] = order[i].Stats.Runs
+			case found:
+				start, end := 0, order[i].Stats.Runs
+				if !starts {
+					start, _ = first.Batter(name)
+				}
+				if !ends {
+					end, _ = last.Batter(name)
+				}
+				stand.Runs[b] = max(end-start, 0)
+			}
+		}
+	}
+
+	return stands
+}
+
+// battingIndex returns the position of a batter in the batting order
+func battingIndex(order []models.BatsmanInfo, name string) int {
+	for i, bat := range order {
+		if strings.EqualFold(bat.Name, name) {
+			return i
+		}
+	}
+	return 0
+}
+
+// findBatter returns the position of a batter in the batting order, if they
+// batted
+func findBatter(order []models.BatsmanInfo, name string) (int, bool) {
+	for i, bat := range order {
+		if samePlayer(bat.Name, name) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// retired reports whether a batter left the crease without being out
+func retired(bat models.BatsmanInfo) bool {
+	return strings.Contains(strings.ToLower(bat.Status), "retired")
+}
+
+// CurrentPartnership returns the stand between the batters in, with the
+// runs and balls sent by Cricbuzz and the runs of each batter worked out
+// from the scorecard where possible
+func CurrentPartnership(match models.MatchInfo, timeline Timeline) (Partnership, bool) {
+	miniscore := match.CricbuzzInfo.Miniscore
+	striker, nonStriker := miniscore.BatsmanStriker.BatName, miniscore.BatsmanNonStriker.BatName
+	if match.IsFinished() || striker == "" || nonStriker == "" {
+		return Partnership{}, false
+	}
+
+	current := Partnership{
+		Batters: [2]string{striker, nonStriker},
+		Runs:    [2]int{-1, -1},
+		Total:   int(miniscore.PartnerShip.Runs),
+		Balls:   int(miniscore.PartnerShip.Balls),
+	}
+	scorecard, ok := currentScorecard(match)
+	if !ok {
+		return current, true
+	}
+
+	stands := Partnerships(scorecard, miniscore.InningsID, timeline)
+	if len(stands) == 0 || !stands[len(stands)-1].Unbroken() {
+		return current, true
+	}
+	last := stands[len(stands)-1]
+	current.Wicket = last.Wicket
+	if current.Total == 0 && current.Balls == 0 {
+		current.Total, current.Balls = last.Total, last.Balls
+	}
+	for i, name := range current.Batters {
+		for j, other := range last.Batters {
+			if samePlayer(name, other) {
+				current.Runs[i] = last.Runs[j]
+			}
+		}
+	}
+	return current, true
+}
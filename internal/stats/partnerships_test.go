@@ -0,0 +1,151 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// batter returns a scorecard row with the runs typed in
+func batter(name, status string, runs int) models.BatsmanInfo {
+	return models.BatsmanInfo{Name: name, Status: status, Stats: models.BattingStats{Runs: runs}}
+}
+
+// fallOfWicket returns a fall of wickets entry at a score after some balls
+func fallOfWicket(wicket, runs int, name string, balls int) models.FallOfWicket {
+	return models.FallOfWicket{Wicket: wicket, Runs: runs, Batter: name, Overs: models.BallsToOvers(balls, 6)}
+}
+
+// standPoint returns a timeline point of the first innings with two batters in
+func standPoint(balls, runs int, a string, aRuns int, b string, bRuns int) Point {
+	return Point{InningsID: 1, Balls: balls, Runs: runs, Batters: [2]BatterScore{{a, aRuns}, {b, bRuns}}}
+}
+
+func TestPartnerships(t *testing.T) {
+	twoDown := models.MatchInningsInfo{
+		BatsmanDetails: []models.BatsmanInfo{
+			batter("Rohit Sharma", "c Smith b Cummins", 40),
+			batter("Shubman Gill", "b Starc", 60),
+			batter("Virat Kohli", "batting", 25),
+			batter("Shreyas Iyer", "batting", 10),
+		},
+		FOW: []models.FallOfWicket{
+			fallOfWicket(1, 50, "Rohit Sharma", 48),
+			fallOfWicket(2, 120, "Shubman Gill", 120),
+		},
+		Totals: models.InningsTotals{Runs: 155, Wickets: 2, Overs: models.BallsToOvers(150, 6)},
+	}
+
+	tests := []struct {
+		name     string
+		innings  models.MatchInningsInfo
+		timeline Timeline
+		want     []Partnership
+	}{
+		{
+			name:    "unbroken last stand without a timeline",
+			innings: twoDown,
+			want: []Partnership{
+				{Wicket: 1, Batters: [2]string{"Rohit Sharma", "Shubman Gill"}, Runs: [2]int{40, -1}, Total: 50, Balls: 48, EndedBy: "Rohit Sharma"},
+				{Wicket: 2, Batters: [2]string{"Virat Kohli", "Shubman Gill"}, Runs: [2]int{-1, -1}, Total: 70, Balls: 72, EndedBy: "Shubman Gill"},
+				{Wicket: 3, Batters: [2]string{"Virat Kohli", "Shreyas Iyer"}, Runs: [2]int{-1, 10}, Total: 35, Balls: 30},
+			},
+		},
+		{
+			name:    "runs split from the timeline",
+			innings: twoDown,
+			timeline: Timeline{
+				standPoint(47, 49, "Rohit Sharma", 39, "Shubman Gill", 10),
+				standPoint(49, 51, "Virat Kohli", 0, "Shubman Gill", 11),
+				standPoint(119, 118, "Virat Kohli", 15, "Shubman Gill", 58),
+				standPoint(121, 121, "Virat Kohli", 16, "Shreyas Iyer", 0),
+				standPoint(150, 155, "Virat Kohli", 25, "Shreyas Iyer", 10),
+			},
+			want: []Partnership{
+				{Wicket: 1, Batters: [2]string{"Rohit Sharma", "Shubman Gill"}, Runs: [2]int{40, 10}, Total: 50, Balls: 48, EndedBy: "Rohit Sharma"},
+				{Wicket: 2, Batters: [2]string{"Virat Kohli", "Shubman Gill"}, Runs: [2]int{15, 49}, Total: 70, Balls: 72, EndedBy: "Shubman Gill"},
+				{Wicket: 3, Batters: [2]string{"Virat Kohli", "Shreyas Iyer"}, Runs: [2]int{9, 10}, Total: 35, Balls: 30},
+			},
+		},
+		{
+			name: "last pair out",
+			innings: models.MatchInningsInfo{
+				BatsmanDetails: []models.BatsmanInfo{
+					batter("Jasprit Bumrah", "b Lyon", 8),
+					batter("Mohammed Siraj", "not out", 4),
+					batter("Mukesh Kumar", "lbw b Lyon", 0),
+				},
+				FOW: []models.FallOfWicket{
+					fallOfWicket(9, 210, "Jasprit Bumrah", 400),
+					fallOfWicket(10, 214, "Mukesh Kumar", 410),
+				},
+				Totals: models.InningsTotals{Runs: 214, Wickets: 10, Overs: models.BallsToOvers(410, 6)},
+			},
+			want: []Partnership{
+				{Wicket: 9, Batters: [2]string{"Jasprit Bumrah", "Mohammed Siraj"}, Runs: [2]int{8, -1}, Total: 210, Balls: 400, EndedBy: "Jasprit Bumrah"},
+				{Wicket: 10, Batters: [2]string{"Mukesh Kumar", "Mohammed Siraj"}, Runs: [2]int{0, -1}, Total: 4, Balls: 10, EndedBy: "Mukesh Kumar"},
+			},
+		},
+		{
+			name: "batter in for one who retired hurt",
+			innings: models.MatchInningsInfo{
+				BatsmanDetails: []models.BatsmanInfo{
+					batter("Rohit Sharma", "retired hurt", 20),
+					batter("Shubman Gill", "batting", 45),
+					batter("Virat Kohli", "c Carey b Hazlewood", 12),
+					batter("Shreyas Iyer", "batting", 8),
+				},
+				FOW: []models.FallOfWicket{
+					fallOfWicket(1, 60, "Virat Kohli", 66),
+				},
+				Totals: models.InningsTotals{Runs: 90, Wickets: 1, Overs: models.BallsToOvers(96, 6)},
+			},
+			want: []Partnership{
+				{Wicket: 1, Batters: [2]string{"Virat Kohli", "Shubman Gill"}, Runs: [2]int{12, -1}, Total: 60, Balls: 66, EndedBy: "Virat Kohli"},
+				{Wicket: 2, Batters: [2]string{"Shreyas Iyer", "Shubman Gill"}, Runs: [2]int{8, -1}, Total: 30, Balls: 30},
+			},
+		},
+		{
+			name: "retired hurt after the last wicket",
+			innings: models.MatchInningsInfo{
+				BatsmanDetails: []models.BatsmanInfo{
+					batter("Rohit Sharma", "c Carey b Hazlewood", 30),
+					batter("Shubman Gill", "retired hurt", 40),
+					batter("Virat Kohli", "batting", 25),
+					batter("Shreyas Iyer", "batting", 5),
+				},
+				FOW: []models.FallOfWicket{
+					fallOfWicket(1, 50, "Rohit Sharma", 60),
+				},
+				Totals: models.InningsTotals{Runs: 100, Wickets: 1, Overs: models.BallsToOvers(120, 6)},
+			},
+			want: []Partnership{
+				{Wicket: 1, Batters: [2]string{"Rohit Sharma", "Shubman Gill"}, Runs: [2]int{30, -1}, Total: 50, Balls: 60, EndedBy: "Rohit Sharma"},
+				{Wicket: 2, Batters: [2]string{"Virat Kohli", "Shreyas Iyer"}, Runs: [2]int{25, 5}, Total: 50, Balls: 60},
+			},
+		},
+		{
+			name: "one batter",
+			innings: models.MatchInningsInfo{
+				BatsmanDetails: []models.BatsmanInfo{batter("Rohit Sharma", "batting", 0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Partnerships(tt.innings, 1, tt.timeline)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Partnerships() = %+v, want %+v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("stand %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+			if n := len(got); n > 0 && got[n-1].Unbroken() != (tt.want[n-1].EndedBy == "") {
+				t.Errorf("Unbroken() = %v for the last stand", got[n-1].Unbroken())
+			}
+		})
+	}
+}
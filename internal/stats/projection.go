@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"math"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// projectionSteps are the run rates above the current one to project at
+var projectionSteps = []float64{0, 1, 2, 4}
+
+// wicketsPace scales the current rate for the rest of the innings by wickets
+// lost. It is a rule of thumb: sides with wickets in hand accelerate at the
+// end, sides that are several down slow down to bat the overs out.
+var wicketsPace = [10]float64{1.15, 1.15, 1.1, 1.0, 1.0, 0.85, 0.85, 0.7, 0.55, 0.4}
+
+// ProjectedScore is the final total reached scoring the rest of the innings at Rate
+type ProjectedScore struct {
+	Rate  float64
+	Total int
+}
+
+// Projection is the projected total of a limited-overs first innings
+type Projection struct {
+	CurrentRate     float64
+	BallsLeft       int
+	Scores          []ProjectedScore
+	WicketsAdjusted int
+}
+
+// ProjectScore projects the final total of a limited-overs first innings at
+// the current rate and at one, two and four runs an over more, along with
+// an estimate that accounts for the wickets already lost
+func ProjectScore(match models.MatchInfo) (Projection, bool) {
+	format := match.CricbuzzInfo.MatchHeader.MatchFormat
+	innings := inningsList(match)
+	if !IsLimitedOvers(format) || len(innings) != 1 {
+		return Projection{}, false
+	}
+
+	current := innings[0]
+	perOver := perOver(match)
+	balls := current.Overs.Balls
+	if balls == 0 || current.Wickets >= 10 {
+		return Projection{}, false
+	}
+
+	projection := Projection{
+		CurrentRate: float64(match.CricbuzzInfo.Miniscore.CurrentRunRate),
+		BallsLeft:   max(InningsBalls(format)-balls, 0),
+	}
+	if projection.CurrentRate <= 0 {
+		projection.CurrentRate = rate(int(current.Score), balls, perOver)
+	}
+
+	oversLeft := float64(projection.BallsLeft) / float64(perOver)
+	project := func(rate float64) int {
+		return int(current.Score) + int(math.Round(rate*oversLeft))
+	}
+
+	for _, step := range projectionSteps {
+		rate := projection.CurrentRate + step
+		projection.Scores = append(projection.Scores, ProjectedScore{Rate: rate, Total: project(rate)})
+	}
+	projection.WicketsAdjusted = project(projection.CurrentRate * wicketsPace[current.Wickets])
+
+	return projection, true
+}
@@ -0,0 +1,114 @@
+package stats
+
+import (
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// Follow-on margins: a Test side that trails by at least this much on first
+// innings can be asked to bat again, in shorter multi-day matches less
+const (
+	testFollowOnMargin       = 200
+	firstClassFollowOnMargin = 150
+)
+
+// FollowOn is where a multi-day match stands on the follow-on
+type FollowOn int
+
+// States of the follow-on
+const (
+	FollowOnNone FollowOn = iota
+	FollowOnPending
+	FollowOnAvoided
+	FollowOnAvailable
+	FollowOnEnforced
+	FollowOnNotEnforced
+)
+
+// Standing is the position of a multi-day match after the first innings
+type Standing struct {
+	BattingTeam string
+	OtherTeam   string
+
+	// Lead is how far the batting side is ahead, negative when it trails
+	Lead int
+
+	// Target is what the side batting last needs to win, zero before the
+	// fourth innings
+	Target int
+
+	FollowOn FollowOn
+
+	// FollowOnTarget is the score the side batting second has to reach to
+	// avoid the follow-on, FollowOnNeeded the runs it still needs
+	FollowOnTarget int
+	FollowOnNeeded int
+}
+
+// Needed returns the runs the side batting last still needs to win
+func (s Standing) Needed() int {
+	return max(-s.Lead+1, 0)
+}
+
+// ComputeStanding works out the lead, trail, follow-on and fourth innings
+// target of a multi-day match from the innings scores
+func ComputeStanding(match models.MatchInfo) (Standing, bool) {
+	format := match.CricbuzzInfo.MatchHeader.MatchFormat
+	innings := inningsList(match)
+	if IsLimitedOvers(format) || len(innings) < 2 || len(innings) > 4 {
+		return Standing{}, false
+	}
+
+	// Runs of each side across its innings
+	current := innings[len(innings)-1]
+	totals := map[uint32]int{}
+	for _, inn := range innings {
+		totals[inn.BatTeamID] += int(inn.Score)
+	}
+	other := innings[0]
+	for _, inn := range innings {
+		if inn.BatTeamID != current.BatTeamID {
+			other = inn
+			break
+		}
+	}
+	if other.BatTeamID == current.BatTeamID {
+		return Standing{}, false
+	}
+
+	standing := Standing{
+		BattingTeam: current.BatTeamName,
+		OtherTeam:   other.BatTeamName,
+		Lead:        totals[current.BatTeamID] - totals[other.BatTeamID],
+	}
+	if len(innings) == 4 {
+		standing.Target = totals[other.BatTeamID] - (totals[current.BatTeamID] - int(current.Score)) + 1
+	}
+
+	// The follow-on is decided on first innings
+	first, second := innings[0], innings[1]
+	margin := firstClassFollowOnMargin
+	if strings.Contains(strings.ToUpper(format), "TEST") {
+		margin = testFollowOnMargin
+	}
+	standing.FollowOnTarget = int(first.Score) - margin + 1
+	secondDone := len(innings) > 2 || second.Wickets >= 10 || second.IsDeclared
+	switch {
+	case second.BatTeamID == first.BatTeamID || standing.FollowOnTarget <= 0:
+		standing.FollowOn = FollowOnNone
+	case int(second.Score) >= standing.FollowOnTarget:
+		standing.FollowOn = FollowOnAvoided
+	case !secondDone:
+		standing.FollowOn = FollowOnPending
+		standing.FollowOnNeeded = standing.FollowOnTarget - int(second.Score)
+	case len(innings) == 2:
+		standing.FollowOn = FollowOnAvailable
+	case innings[2].BatTeamID == second.BatTeamID || innings[2].IsFollowOn:
+		standing.FollowOn = FollowOnEnforced
+	default:
+		standing.FollowOn = FollowOnNotEnforced
+	}
+
+	return standing, true
+}
@@ -0,0 +1,336 @@
+package stats
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// summaryTop is how many batters and bowlers of each side a summary lists
+const summaryTop = 3
+
+// Collapses are flagged when at least collapseWickets fall for at most
+// collapseRuns
+const (
+	collapseWickets = 4
+	collapseRuns    = 30
+)
+
+// Summary is the result card of a completed match
+type Summary struct {
+	Title  string
+	Series string
+	Status string
+
+	// Winner and Margin are empty for ties, draws and abandoned matches
+	Winner string
+	Margin string
+
+	PlayersOfTheMatch  []string
+	PlayersOfTheSeries []string
+	Innings            []string
+	Sides              []SideSummary
+	Moments            []string
+}
+
+// SideSummary is the best batting and bowling of a side in a match
+type SideSummary struct {
+	Team    string
+	Batters []TopBatter
+	Bowlers []TopBowler
+}
+
+// TopBatter is an innings of a batter listed in a summary
+type TopBatter struct {
+	Name   string
+	Runs   int
+	Balls  int
+	NotOut bool
+}
+
+// String formats the innings, e.g. "Kohli 82* (53)"
+func (b TopBatter) String() string {
+	notOut := ""
+	if b.NotOut {
+		notOut = "*"
+	}
+	return fmt.Sprintf("%s %d%s (%d)", b.Name, b.Runs, notOut, b.Balls)
+}
+
+// TopBowler is the figures of a bowler in an innings listed in a summary
+type TopBowler struct {
+	Name    string
+	Wickets int
+	Runs    int
+	Overs   models.Overs
+}
+
+// String formats the figures, e.g. "Bumrah 3/24 (4.0)"
+func (b TopBowler) String() string {
+	return fmt.Sprintf("%s %d/%d (%s)", b.Name, b.Wickets, b.Runs, b.Overs)
+}
+
+// ComputeSummary builds the result card of a completed match from its
+// header and scorecard
+func ComputeSummary(match models.MatchInfo) (Summary, bool) {
+	header := match.CricbuzzInfo.MatchHeader
+	if !match.IsFinished() {
+		return Summary{}, false
+	}
+
+	summary := Summary{
+		Title:  fmt.Sprintf("%s vs %s - %s", header.Team1.Name, header.Team2.Name, header.MatchFormat),
+		Status: header.Status,
+	}
+	if header.SeriesName != "" {
+		summary.Series = strings.TrimPrefix(header.MatchDescription+", "+header.SeriesName, ", ")
+	}
+	if result := header.Result; result != nil && result.WinningTeam != "" {
+		summary.Winner = result.WinningTeam
+		summary.Margin = winningMargin(*result)
+	}
+	for _, player := range header.PlayersOfTheMatch {
+		summary.PlayersOfTheMatch = append(summary.PlayersOfTheMatch, cmp.Or(player.FullName, player.Name))
+	}
+	for _, player := range header.PlayersOfTheSeries {
+		summary.PlayersOfTheSeries = append(summary.PlayersOfTheSeries, cmp.Or(player.FullName, player.Name))
+	}
+
+	innings := inningsList(match)
+	for _, inn := range innings {
+		score := fmt.Sprintf("%s %d/%d (%s)", inn.BatTeamName, inn.Score, inn.Wickets, inn.Overs)
+		switch {
+		case inn.IsDeclared:
+			score += " dec"
+		case inn.IsFollowOn:
+			score += " f/o"
+		}
+		summary.Innings = append(summary.Innings, score)
+	}
+
+	// Each side bats in its own innings and bowls in the others. Both sides
+	// come from the header, so one that never batted still gets its bowling.
+	var teams []uint32
+	sides := map[uint32]*SideSummary{}
+	addSide := func(id uint32, name string) {
+		if side, ok := sides[id]; ok {
+			side.Team = cmp.Or(name, side.Team)
+			return
+		}
+		teams = append(teams, id)
+		sides[id] = &SideSummary{Team: name}
+	}
+	for _, team := range []models.Team{header.Team1, header.Team2} {
+		if team.ID != 0 {
+			addSide(team.ID, cmp.Or(team.ShortName, team.Name))
+		}
+	}
+	for _, inn := range innings {
+		addSide(inn.BatTeamID, inn.BatTeamName)
+	}
+	for i, inn := range innings {
+		if i >= len(match.Scorecard) {
+			break
+		}
+		card := match.Scorecard[i]
+		batting := sides[inn.BatTeamID]
+		for _, bat := range card.BatsmanDetails {
+			if bat.Stats.Balls == 0 && bat.Stats.Runs == 0 {
+				continue
+			}
+			status := strings.ToLower(bat.Status)
+			batting.Batters = append(batting.Batters, TopBatter{
+				Name:   bat.Name,
+				Runs:   bat.Stats.Runs,
+				Balls:  bat.Stats.Balls,
+				NotOut: strings.Contains(status, "not out") || status == "batting",
+			})
+		}
+		for _, id := range teams {
+			if id == inn.BatTeamID {
+				continue
+			}
+			for _, bowl := range card.BowlerDetails {
+				sides[id].Bowlers = append(sides[id].Bowlers, TopBowler{
+					Name:    bowl.Name,
+					Wickets: bowl.Stats.Wickets,
+					Runs:    bowl.Stats.Runs,
+					Overs:   bowl.Stats.Overs,
+				})
+			}
+		}
+	}
+	for _, id := range teams {
+		side := sides[id]
+		if len(side.Batters) == 0 && len(side.Bowlers) == 0 {
+			continue
+		}
+		slices.SortStableFunc(side.Batters, func(a, b TopBatter) int {
+			return cmp.Or(cmp.Compare(b.Runs, a.Runs), cmp.Compare(a.Balls, b.Balls))
+		})
+		slices.SortStableFunc(side.Bowlers, func(a, b TopBowler) int {
+			return cmp.Or(cmp.Compare(b.Wickets, a.Wickets), cmp.Compare(a.Runs, b.Runs))
+		})
+		side.Batters = side.Batters[:min(len(side.Batters), summaryTop)]
+		side.Bowlers = side.Bowlers[:min(len(side.Bowlers), summaryTop)]
+		summary.Sides = append(summary.Sides, *side)
+	}
+
+	summary.Moments = keyMoments(match, innings)
+	return summary, true
+}
+
+// winningMargin formats the margin of a win, e.g. "7 wickets" or "an
+// innings and 32 runs"
+func winningMargin(result models.Result) string {
+	if result.WinningMargin == 0 {
+		return ""
+	}
+	unit := "wicket"
+	if result.WinByRuns || result.WinByInnings {
+		unit = "run"
+	}
+	if result.WinningMargin != 1 {
+		unit += "s"
+	}
+	margin := fmt.Sprintf("%d %s", result.WinningMargin, unit)
+	if result.WinByInnings {
+		margin = "an innings and " + margin
+	}
+	return margin
+}
+
+// keyMoments picks out the landmark innings, big hauls, the highest stand
+// and any collapse of a match from its scorecard
+func keyMoments(match models.MatchInfo, innings []models.InningsScore) []string {
+	format := match.CricbuzzInfo.MatchHeader.MatchFormat
+	landmark, haul := 100, 5
+	if balls := InningsBalls(format); balls > 0 && balls <= 20*6 {
+		landmark, haul = 50, 4
+	}
+
+	var moments []string
+	var best Partnership
+	bestTeam := ""
+	for i, inn := range innings {
+		if i >= len(match.Scorecard) {
+			break
+		}
+		card := match.Scorecard[i]
+		team := inn.BatTeamName
+		for _, bat := range card.BatsmanDetails {
+			if bat.Stats.Runs >= landmark {
+				moments = append(moments, fmt.Sprintf("%s %d off %d balls for %s", bat.Name, bat.Stats.Runs, bat.Stats.Balls, team))
+			}
+		}
+		for _, bowl := range card.BowlerDetails {
+			if bowl.Stats.Wickets >= haul {
+				moments = append(moments, fmt.Sprintf("%s took %d/%d against %s", bowl.Name, bowl.Stats.Wickets, bowl.Stats.Runs, team))
+			}
+		}
+
+		for _, stand := range Partnerships(card, inn.InningsID, nil) {
+			if stand.Total > best.Total {
+				best, bestTeam = stand, team
+			}
+		}
+		if collapse, ok := findCollapse(card.FOW); ok {
+			moments = append(moments, fmt.Sprintf("%s lost %s", team, collapse))
+		}
+	}
+	if best.Total > 0 {
+		moments = append(moments, fmt.Sprintf("Highest stand %d for the %s wicket by %s and %s for %s",
+			best.Total, Ordinal(best.Wicket), best.Batters[0], best.Batters[1], bestTeam))
+	}
+	return moments
+}
+
+// findCollapse returns the worst run of wickets for few runs in the fall of
+// wickets of an innings, e.g. "5 wickets for 22 runs (120/2 to 142/7)"
+func findCollapse(fow []models.FallOfWicket) (string, bool) {
+	found := ""
+	most := 0
+	for start := range fow {
+		// The score before the first wicket of the run
+		from, fromWickets := 0, 0
+		if start > 0 {
+			from, fromWickets = fow[start-1].Runs, fow[start-1].Wicket
+		}
+		for end := start; end < len(fow); end++ {
+			runs := fow[end].Runs - from
+			wickets := fow[end].Wicket - fromWickets
+			if runs > collapseRuns {
+				break
+			}
+			if wickets >= collapseWickets && wickets > most {
+				most = wickets
+				found = fmt.Sprintf("%d wickets for %d runs (%d/%d to %d/%d)",
+					wickets, runs, from, fromWickets, fow[end].Runs, fow[end].Wicket)
+			}
+		}
+	}
+	return found, most > 0
+}
+
+// Ordinal formats a number as 1st, 2nd, 3rd, 4th...
+func Ordinal(n int) string {
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return fmt.Sprintf("%dth", n)
+	case n%10 == 1:
+		return fmt.Sprintf("%dst", n)
+	case n%10 == 2:
+		return fmt.Sprintf("%dnd", n)
+	case n%10 == 3:
+		return fmt.Sprintf("%drd", n)
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
+
+// Text formats the summary as plain text to share or save
+func (s Summary) Text() string {
+	var text strings.Builder
+	text.WriteString(s.Title + "\n")
+	if s.Series != "" {
+		text.WriteString(s.Series + "\n")
+	}
+	text.WriteString("\n")
+
+	for _, score := range s.Innings {
+		text.WriteString(score + "\n")
+	}
+	switch {
+	case s.Winner != "" && s.Margin != "":
+		fmt.Fprintf(&text, "%s won by %s\n", s.Winner, s.Margin)
+	case s.Status != "":
+		text.WriteString(s.Status + "\n")
+	}
+	if len(s.PlayersOfTheMatch) > 0 {
+		fmt.Fprintf(&text, "Player of the match: %s\n", strings.Join(s.PlayersOfTheMatch, ", "))
+	}
+	if len(s.PlayersOfTheSeries) > 0 {
+		fmt.Fprintf(&text, "Player of the series: %s\n", strings.Join(s.PlayersOfTheSeries, ", "))
+	}
+
+	for _, side := range s.Sides {
+		fmt.Fprintf(&text, "\n%s\n", side.Team)
+		for _, bat := range side.Batters {
+			fmt.Fprintf(&text, "  Bat   %s\n", bat)
+		}
+		for _, bowl := range side.Bowlers {
+			fmt.Fprintf(&text, "  Bowl  %s\n", bowl)
+		}
+	}
+
+	if len(s.Moments) > 0 {
+		text.WriteString("\nKey moments\n")
+		for _, moment := range s.Moments {
+			fmt.Fprintf(&text, "  - %s\n", moment)
+		}
+	}
+	return text.String()
+}
@@ -0,0 +1,86 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// finishedMatch returns a completed match between India and Australia with
+// the given innings, India batting first
+func finishedMatch(scorecard ...models.MatchInningsInfo) models.MatchInfo {
+	var match models.MatchInfo
+	header := &match.CricbuzzInfo.MatchHeader
+	header.MatchFormat = "ODI"
+	header.Complete = true
+	header.Team1 = models.Team{ID: 2, Name: "India", ShortName: "IND"}
+	header.Team2 = models.Team{ID: 4, Name: "Australia", ShortName: "AUS"}
+
+	teams := []models.Team{header.Team1, header.Team2}
+	for i := range scorecard {
+		team := teams[i%2]
+		match.CricbuzzInfo.Miniscore.MatchScoreDetails.InningsScoreList = append(
+			match.CricbuzzInfo.Miniscore.MatchScoreDetails.InningsScoreList,
+			models.InningsScore{InningsID: uint32(i + 1), BatTeamID: team.ID, BatTeamName: team.ShortName},
+		)
+	}
+	match.Scorecard = scorecard
+	return match
+}
+
+// cardOf returns a scorecard innings with one batter and one bowler
+func cardOf(bat string, runs int, bowl string, wickets int) models.MatchInningsInfo {
+	return models.MatchInningsInfo{
+		BatsmanDetails: []models.BatsmanInfo{{Name: bat, Status: "not out", Stats: models.BattingStats{Runs: runs, Balls: runs}}},
+		BowlerDetails:  []models.BowlerInfo{{Name: bowl, Stats: models.BowlingStats{Wickets: wickets}}},
+	}
+}
+
+func TestComputeSummarySides(t *testing.T) {
+	type side struct {
+		team    string
+		batters int
+		bowlers int
+	}
+	tests := []struct {
+		name  string
+		match models.MatchInfo
+		want  []side
+	}{
+		{
+			name: "both sides batted",
+			match: finishedMatch(
+				cardOf("Rohit Sharma", 80, "Mitchell Starc", 2),
+				cardOf("Travis Head", 60, "Jasprit Bumrah", 3),
+			),
+			want: []side{{"IND", 1, 1}, {"AUS", 1, 1}},
+		},
+		{
+			name:  "second side never batted",
+			match: finishedMatch(cardOf("Rohit Sharma", 80, "Mitchell Starc", 2)),
+			want:  []side{{"IND", 1, 0}, {"AUS", 0, 1}},
+		},
+		{
+			name:  "no play",
+			match: finishedMatch(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, ok := ComputeSummary(tt.match)
+			if !ok {
+				t.Fatal("ComputeSummary() of a finished match failed")
+			}
+			if len(summary.Sides) != len(tt.want) {
+				t.Fatalf("ComputeSummary() sides = %+v, want %+v", summary.Sides, tt.want)
+			}
+			for i, want := range tt.want {
+				got := summary.Sides[i]
+				if got.Team != want.team || len(got.Batters) != want.batters || len(got.Bowlers) != want.bowlers {
+					t.Errorf("side %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
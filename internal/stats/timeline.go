@@ -0,0 +1,119 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// Point is the score of an innings at a moment of the match
+type Point struct {
+	Time      time.Time
+	InningsID uint32
+	Runs      int
+	Wickets   int
+	Balls     int
+	Batters   [2]BatterScore
+
+	// Allotted is the legal balls the innings was cut to, zero unless its
+	// overs were reduced
+	Allotted int
+
+	// Day of a multi-day match, the session when Cricbuzz names it and the
+	// break play is in, like "lunch" or "stumps"
+	Day     int
+	Session int
+	Break   string
+}
+
+// BatterScore is the score of a batter in at a point of the match
+type BatterScore struct {
+	Name string
+	Runs int
+}
+
+// Timeline is the progression of a match, oldest point first
+type Timeline []Point
+
+// CurrentPoint returns the score of the innings in progress in a snapshot
+func CurrentPoint(match models.MatchInfo) (Point, bool) {
+	miniscore := match.CricbuzzInfo.Miniscore
+	for _, innings := range miniscore.MatchScoreDetails.InningsScoreList {
+		if innings.InningsID != miniscore.InningsID {
+			continue
+		}
+		header := match.CricbuzzInfo.MatchHeader
+		day := 0
+		if header.DayNumber != nil {
+			day = int(*header.DayNumber)
+		}
+		return Point{
+			Time:      match.LastUpdated,
+			InningsID: innings.InningsID,
+			Runs:      int(innings.Score),
+			Wickets:   int(innings.Wickets),
+			Balls:     innings.Overs.Balls,
+			Batters: [2]BatterScore{
+				{Name: miniscore.BatsmanStriker.BatName, Runs: int(miniscore.BatsmanStriker.BatRuns)},
+				{Name: miniscore.BatsmanNonStriker.BatName, Runs: int(miniscore.BatsmanNonStriker.BatRuns)},
+			},
+			Allotted: reducedBalls(match, innings.Overs.Balls),
+			Day:      day,
+			Session:  sessionOf(miniscore.Status, header.Status),
+			Break:    breakOf(header.State, miniscore.Status, header.Status),
+		}, true
+	}
+	return Point{}, false
+}
+
+// Add returns the timeline with the current score of a snapshot appended,
+// unless the score has not moved since the last point
+func (t Timeline) Add(match models.MatchInfo) Timeline {
+	p, ok := CurrentPoint(match)
+	if !ok {
+		return t
+	}
+	if len(t) > 0 {
+		last := t[len(t)-1]
+		if last.InningsID == p.InningsID && last.Balls == p.Balls &&
+			last.Runs == p.Runs && last.Wickets == p.Wickets &&
+			last.Allotted == p.Allotted && last.Day == p.Day && last.Break == p.Break {
+			return t
+		}
+	}
+	return append(t, p)
+}
+
+// Innings returns the points of a single innings
+func (t Timeline) Innings(inningsID uint32) Timeline {
+	var points Timeline
+	for _, p := range t {
+		if p.InningsID == inningsID {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Batter returns the runs of a batter at the point, if they were in
+func (p Point) Batter(name string) (int, bool) {
+	for _, bat := range p.Batters {
+		if bat.Name != "" && samePlayer(bat.Name, name) {
+			return bat.Runs, true
+		}
+	}
+	return 0, false
+}
+
+// At returns the last point of an innings with at most the given number of
+// balls bowled
+func (t Timeline) At(inningsID uint32, balls int) (Point, bool) {
+	var found Point
+	ok := false
+	for _, p := range t {
+		if p.InningsID == inningsID && p.Balls <= balls && (!ok || p.Balls >= found.Balls) {
+			found, ok = p, true
+		}
+	}
+	return found, ok
+}
@@ -0,0 +1,184 @@
+package stats
+
+import (
+	"math"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// logisticScale makes the logistic curve match a normal distribution
+const logisticScale = 1.7
+
+// averageTotal returns the runs a side is expected to score in a full
+// innings of a format, or zero for formats without an overs limit
+func averageTotal(format string) float64 {
+	switch f := strings.ToUpper(format); {
+	case strings.Contains(f, "HUNDRED"):
+		return 145
+	case strings.Contains(f, "T20"):
+		return 160
+	case strings.Contains(f, "T10"):
+		return 110
+	case strings.Contains(f, "ODI"), strings.Contains(f, "LIST A"):
+		return dlsG50
+	default:
+		return 0
+	}
+}
+
+// expectedRuns returns the runs a side is expected to add with the balls
+// left and wickets lost, scaling the format's average total by the DLS
+// resources left
+func expectedRuns(format string, ballsLeft, wicketsLost int) float64 {
+	full := Resources(InningsBalls(format), 0)
+	if full <= 0 {
+		return 0
+	}
+	return averageTotal(format) * Resources(ballsLeft, wicketsLost) / full
+}
+
+// runsSpread returns how far the runs actually scored usually stray from
+// the runs expected
+func runsSpread(expected float64) float64 {
+	return 0.2*expected + 4
+}
+
+// logistic maps a standard score to a probability
+func logistic(z float64) float64 {
+	return 1 / (1 + math.Exp(-logisticScale*z))
+}
+
+// WinState is the state of a limited-overs innings
+type WinState struct {
+	Format string
+
+	// Target is the score to chase, zero in the first innings
+	Target int
+
+	Runs     int
+	Wickets  int
+	Balls    int
+	MaxBalls int
+}
+
+// BattingWin returns the probability that the batting side wins the match.
+// Runs still to come are taken to be normally distributed around the runs
+// expected from the resources left, so the same state always gives the
+// same probability.
+func (s WinState) BattingWin() float64 {
+	ballsLeft := max(s.MaxBalls-s.Balls, 0)
+	if s.Wickets >= 10 {
+		ballsLeft = 0
+	}
+	expected := expectedRuns(s.Format, ballsLeft, s.Wickets)
+
+	if s.Target > 0 {
+		needed := s.Target - s.Runs
+		switch {
+		case needed <= 0:
+			return 1
+		case ballsLeft == 0:
+			return 0
+		}
+		return logistic((expected - float64(needed)) / runsSpread(expected))
+	}
+
+	// The first innings is a race between the projected total and the runs
+	// the chasing side is expected to score
+	projected := float64(s.Runs) + expected
+	chase := averageTotal(s.Format)
+	spread := math.Hypot(runsSpread(expected), runsSpread(chase))
+	return logistic((projected - chase) / spread)
+}
+
+// WinProbability is the chance of the batting side winning a limited-overs match
+type WinProbability struct {
+	BattingTeam string
+	BowlingTeam string
+	Batting     float64
+}
+
+// Bowling returns the probability that the bowling side wins
+func (p WinProbability) Bowling() float64 {
+	return 1 - p.Batting
+}
+
+// ComputeWinProbability estimates the chance of each side winning a
+// limited-overs match in its first or second innings
+func ComputeWinProbability(match models.MatchInfo, timeline Timeline) (WinProbability, bool) {
+	format := match.CricbuzzInfo.MatchHeader.MatchFormat
+	innings := inningsList(match)
+	if averageTotal(format) == 0 || len(innings) == 0 || len(innings) > 2 {
+		return WinProbability{}, false
+	}
+
+	current := innings[len(innings)-1]
+	state := WinState{
+		Format:   format,
+		Runs:     int(current.Score),
+		Wickets:  int(current.Wickets),
+		Balls:    current.Overs.Balls,
+		MaxBalls: InningsBalls(format),
+	}
+	if chase, ok := ComputeChase(match, timeline); ok {
+		state.Target = chase.Target
+		state.MaxBalls = current.Overs.Balls + chase.BallsLeft
+	}
+
+	return WinProbability{
+		BattingTeam: current.BatTeamName,
+		BowlingTeam: teamAgainst(match, current.BatTeamID),
+		Batting:     state.BattingWin(),
+	}, true
+}
+
+// WinHistory returns the chance of the side batting first winning at every
+// point of the timeline of a limited-overs match. The chase is replayed
+// against the target and overs it has now, DLS revised like the ones
+// ComputeWinProbability uses, so the latest point agrees with it.
+func WinHistory(match models.MatchInfo, timeline Timeline) []float64 {
+	format := match.CricbuzzInfo.MatchHeader.MatchFormat
+	innings := inningsList(match)
+	if averageTotal(format) == 0 || len(innings) == 0 {
+		return nil
+	}
+
+	target, chaseBalls := 0, InningsBalls(format)
+	if len(innings) > 1 {
+		target = int(innings[0].Score) + 1
+		if chase, ok := ComputeChase(match, timeline); ok {
+			target = chase.Target
+			chaseBalls = innings[1].Overs.Balls + chase.BallsLeft
+		}
+	}
+
+	var history []float64
+	for _, p := range timeline {
+		state := WinState{
+			Format:   format,
+			Runs:     p.Runs,
+			Wickets:  p.Wickets,
+			Balls:    p.Balls,
+			MaxBalls: InningsBalls(format),
+		}
+		switch {
+		case p.InningsID == innings[0].InningsID:
+			history = append(history, state.BattingWin())
+		case len(innings) > 1 && p.InningsID == innings[1].InningsID:
+			state.Target = target
+			state.MaxBalls = chaseBalls
+			history = append(history, 1-state.BattingWin())
+		}
+	}
+	return history
+}
+
+// teamAgainst returns the short name of the team playing the given one
+func teamAgainst(match models.MatchInfo, teamID uint32) string {
+	header := match.CricbuzzInfo.MatchHeader
+	if header.Team1.ID == teamID {
+		return header.Team2.ShortName
+	}
+	return header.Team1.ShortName
+}
@@ -0,0 +1,219 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// winTolerance is how far a computed probability may stray from a pinned one
+const winTolerance = 0.001
+
+func TestBattingWin(t *testing.T) {
+	tests := []struct {
+		name  string
+		state WinState
+		want  float64
+	}{
+		{
+			name:  "target reached",
+			state: WinState{Format: "T20", Target: 161, Runs: 161, Wickets: 5, Balls: 100, MaxBalls: 120},
+			want:  1,
+		},
+		{
+			name:  "target passed with the last ball",
+			state: WinState{Format: "ODI", Target: 250, Runs: 253, Wickets: 9, Balls: 300, MaxBalls: 300},
+			want:  1,
+		},
+		{
+			name:  "no balls left in the chase",
+			state: WinState{Format: "T20", Target: 161, Runs: 155, Wickets: 4, Balls: 120, MaxBalls: 120},
+			want:  0,
+		},
+		{
+			name:  "all out in the chase",
+			state: WinState{Format: "ODI", Target: 280, Runs: 240, Wickets: 10, Balls: 270, MaxBalls: 300},
+			want:  0,
+		},
+		{
+			name:  "T20 first ball",
+			state: WinState{Format: "T20", MaxBalls: 120},
+			want:  0.5,
+		},
+		{
+			name:  "T20 first innings 120/2 after 15 overs",
+			state: WinState{Format: "T20", Runs: 120, Wickets: 2, Balls: 90, MaxBalls: 120},
+			want:  0.5821,
+		},
+		{
+			name:  "T20 chasing 181, 120/3 after 15 overs",
+			state: WinState{Format: "T20", Target: 181, Runs: 120, Wickets: 3, Balls: 90, MaxBalls: 120},
+			want:  0.1381,
+		},
+		{
+			name:  "T20 chasing 161, 100/7 after 16 overs",
+			state: WinState{Format: "T20", Target: 161, Runs: 100, Wickets: 7, Balls: 96, MaxBalls: 120},
+			want:  0.0055,
+		},
+		{
+			name:  "ODI first innings 250/4 after 40 overs",
+			state: WinState{Format: "ODI", Runs: 250, Wickets: 4, Balls: 240, MaxBalls: 300},
+			want:  0.9055,
+		},
+		{
+			name:  "ODI chasing 301, 150/2 after 25 overs",
+			state: WinState{Format: "ODI", Target: 301, Runs: 150, Wickets: 2, Balls: 150, MaxBalls: 300},
+			want:  0.4650,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.state.BattingWin()
+			if math.Abs(got-tt.want) > winTolerance {
+				t.Errorf("BattingWin() = %.4f, want %.4f", got, tt.want)
+			}
+			if again := tt.state.BattingWin(); again != got {
+				t.Errorf("BattingWin() = %v then %v, want the same", got, again)
+			}
+		})
+	}
+}
+
+func TestBattingWinTrends(t *testing.T) {
+	tests := []struct {
+		name  string
+		state WinState
+	}{
+		{"T20 first innings", WinState{Format: "T20", Runs: 80, Wickets: 3, Balls: 60, MaxBalls: 120}},
+		{"T20 chase", WinState{Format: "T20", Target: 171, Runs: 80, Wickets: 3, Balls: 60, MaxBalls: 120}},
+		{"ODI first innings", WinState{Format: "ODI", Runs: 150, Wickets: 3, Balls: 150, MaxBalls: 300}},
+		{"ODI chase", WinState{Format: "ODI", Target: 281, Runs: 150, Wickets: 3, Balls: 150, MaxBalls: 300}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := tt.state.BattingWin()
+
+			more := tt.state
+			more.Runs += 20
+			if got := more.BattingWin(); got <= base {
+				t.Errorf("with 20 more runs BattingWin() = %.4f, want more than %.4f", got, base)
+			}
+
+			fewer := tt.state
+			fewer.Wickets += 3
+			if got := fewer.BattingWin(); got >= base {
+				t.Errorf("with 3 more wickets down BattingWin() = %.4f, want less than %.4f", got, base)
+			}
+		})
+	}
+}
+
+// winMatch returns a match of the format with the given innings, the side
+// with ID 1 batting first
+func winMatch(format string, innings ...models.InningsScore) models.MatchInfo {
+	var match models.MatchInfo
+	header := &match.CricbuzzInfo.MatchHeader
+	header.MatchFormat = format
+	header.Team1 = models.Team{ID: 1, ShortName: "IND"}
+	header.Team2 = models.Team{ID: 2, ShortName: "AUS"}
+	match.CricbuzzInfo.Miniscore.MatchScoreDetails.InningsScoreList = innings
+	return match
+}
+
+func TestComputeWinProbability(t *testing.T) {
+	first := models.InningsScore{InningsID: 1, BatTeamID: 1, BatTeamName: "IND", Score: 120, Wickets: 2, Overs: models.BallsToOvers(90, 6)}
+	chase := models.InningsScore{InningsID: 2, BatTeamID: 2, BatTeamName: "AUS", Score: 120, Wickets: 3, Overs: models.BallsToOvers(90, 6)}
+
+	tests := []struct {
+		name    string
+		match   models.MatchInfo
+		ok      bool
+		batting string
+		bowling string
+		want    float64
+	}{
+		{
+			name:    "T20 first innings",
+			match:   winMatch("T20", first),
+			ok:      true,
+			batting: "IND",
+			bowling: "AUS",
+			want:    0.5821,
+		},
+		{
+			name: "T20 chase",
+			match: winMatch("T20",
+				models.InningsScore{InningsID: 1, BatTeamID: 1, BatTeamName: "IND", Score: 180, Wickets: 6, Overs: models.BallsToOvers(120, 6)},
+				chase),
+			ok:      true,
+			batting: "AUS",
+			bowling: "IND",
+			want:    0.1381,
+		},
+		{
+			name: "T20 chase won",
+			match: winMatch("T20",
+				models.InningsScore{InningsID: 1, BatTeamID: 1, BatTeamName: "IND", Score: 110, Wickets: 10, Overs: models.BallsToOvers(110, 6)},
+				chase),
+			ok:      true,
+			batting: "AUS",
+			bowling: "IND",
+			want:    1,
+		},
+		{
+			name:  "no innings yet",
+			match: winMatch("T20"),
+		},
+		{
+			name:  "Test match",
+			match: winMatch("TEST", first),
+		},
+		{
+			name:  "super over",
+			match: winMatch("T20", first, chase, models.InningsScore{InningsID: 3, BatTeamID: 1}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ComputeWinProbability(tt.match, nil)
+			if ok != tt.ok {
+				t.Fatalf("ComputeWinProbability() ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if got.BattingTeam != tt.batting || got.BowlingTeam != tt.bowling {
+				t.Errorf("teams = %s v %s, want %s v %s", got.BattingTeam, got.BowlingTeam, tt.batting, tt.bowling)
+			}
+			if math.Abs(got.Batting-tt.want) > winTolerance {
+				t.Errorf("Batting = %.4f, want %.4f", got.Batting, tt.want)
+			}
+			if math.Abs(got.Batting+got.Bowling()-1) > 1e-9 {
+				t.Errorf("Batting + Bowling() = %v, want 1", got.Batting+got.Bowling())
+			}
+		})
+	}
+}
+
+func TestWinHistoryMatchesHeadline(t *testing.T) {
+	before := reducedChase(90, 2, 108)
+	before.CricbuzzInfo.Miniscore.OversRem = nil
+	match := reducedChase(120, 6, 150)
+	timeline := Timeline{}.Add(before).Add(reducedChase(100, 2, 120)).Add(match)
+
+	win, ok := ComputeWinProbability(match, timeline)
+	if !ok {
+		t.Fatal("ComputeWinProbability() ok = false, want true")
+	}
+	history := WinHistory(match, timeline)
+	if len(history) != len(timeline) {
+		t.Fatalf("len(WinHistory()) = %d, want %d", len(history), len(timeline))
+	}
+	if last := history[len(history)-1]; math.Abs(last-win.Bowling()) > 1e-9 {
+		t.Errorf("last WinHistory() point = %.4f, want %.4f as the headline", last, win.Bowling())
+	}
+}
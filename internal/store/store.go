@@ -0,0 +1,326 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// fileExt is the extension of the per-match snapshot files
+const fileExt = ".jsonl"
+
+// Snapshot is the state of a match at a point in time
+type Snapshot struct {
+	Time  time.Time        `json:"time"`
+	Hash  string           `json:"hash"`
+	Match models.MatchInfo `json:"match"`
+}
+
+// Summary describes the snapshots stored for a single match
+type Summary struct {
+	MatchID   uint32
+	Name      string
+	Format    string
+	Status    string
+	Complete  bool
+	Snapshots int
+	First     time.Time
+	Last      time.Time
+}
+
+// Store saves match snapshots as one JSON Lines file per match
+type Store struct {
+	dir      string
+	mu       sync.Mutex
+	lastHash map[uint32]string
+}
+
+// DefaultDir returns the history directory inside the XDG data directory
+func DefaultDir() (string, error) {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find home directory: %v", err)
+		}
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataHome, "crictty", "history"), nil
+}
+
+// Open opens the store in dir, creating the directory if needed
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create history directory: %v", err)
+	}
+	return &Store{
+		dir:      dir,
+		lastHash: make(map[uint32]string),
+	}, nil
+}
+
+// Dir returns the directory the store lives in
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// path returns the snapshot file of a match
+func (s *Store) path(matchID uint32) string {
+	return filepath.Join(s.dir, strconv.FormatUint(uint64(matchID), 10)+fileExt)
+}
+
+// Save appends a snapshot of the match unless it is identical to the last one
+// stored. It reports whether a snapshot was written.
+func (s *Store) Save(match models.MatchInfo) (bool, error) {
+	hash, err := hashMatch(match)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last, ok := s.lastHash[match.CricbuzzMatchID]
+	if !ok {
+		last, err = s.readLastHash(match.CricbuzzMatchID)
+		if err != nil {
+			return false, err
+		}
+	}
+	if last == hash {
+		s.lastHash[match.CricbuzzMatchID] = hash
+		return false, nil
+	}
+
+	taken := match.LastUpdated
+	if taken.IsZero() {
+		taken = time.Now()
+	}
+	line, err := json.Marshal(Snapshot{Time: taken, Hash: hash, Match: match})
+	if err != nil {
+		return false, fmt.Errorf("failed to encode snapshot: %v", err)
+	}
+
+	f, err := os.OpenFile(s.path(match.CricbuzzMatchID), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return false, fmt.Errorf("failed to open history file: %v", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return false, fmt.Errorf("failed to write snapshot: %v", err)
+	}
+
+	s.lastHash[match.CricbuzzMatchID] = hash
+	return true, nil
+}
+
+// Snapshots returns every stored snapshot of a match, oldest first
+func (s *Store) Snapshots(matchID uint32) ([]Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var snapshots []Snapshot
+	err := s.scan(matchID, func(line []byte) error {
+		var snap Snapshot
+		if err := json.Unmarshal(line, &snap); err != nil {
+			return fmt.Errorf("failed to decode snapshot: %v", err)
+		}
+		snap.Match.ApplyBallsPerOver()
+		snapshots = append(snapshots, snap)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+	return snapshots, nil
+}
+
+// List summarises every stored match, most recently updated first
+func (s *Store) List() ([]Summary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids, err := s.matchIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]Summary, 0, len(ids))
+	for _, id := range ids {
+		summary := Summary{MatchID: id}
+		var last []byte
+		err := s.scan(id, func(line []byte) error {
+			if summary.Snapshots == 0 {
+				var first struct {
+					Time time.Time `json:"time"`
+				}
+				if err := json.Unmarshal(line, &first); err == nil {
+					summary.First = first.Time
+				}
+			}
+			summary.Snapshots++
+			last = append(last[:0], line...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if summary.Snapshots == 0 {
+			continue
+		}
+
+		var snap Snapshot
+		if err := json.Unmarshal(last, &snap); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot of match %d: %v", id, err)
+		}
+		header := snap.Match.CricbuzzInfo.MatchHeader
+		summary.Name = displayName(snap.Match)
+		summary.Format = header.MatchFormat
+		summary.Status = header.Status
+		summary.Complete = header.Complete
+		summary.Last = snap.Time
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Last.After(summaries[j].Last)
+	})
+	return summaries, nil
+}
+
+// Delete removes every snapshot of a match
+func (s *Store) Delete(matchID uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.lastHash, matchID)
+	if err := os.Remove(s.path(matchID)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("no history for match %d", matchID)
+		}
+		return fmt.Errorf("failed to delete history of match %d: %v", matchID, err)
+	}
+	return nil
+}
+
+// Prune deletes every match whose latest snapshot is older than cutoff and
+// returns the IDs of the deleted matches
+func (s *Store) Prune(cutoff time.Time) ([]uint32, error) {
+	summaries, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []uint32
+	for _, summary := range summaries {
+		if summary.Last.Before(cutoff) {
+			if err := s.Delete(summary.MatchID); err != nil {
+				return pruned, err
+			}
+			pruned = append(pruned, summary.MatchID)
+		}
+	}
+	return pruned, nil
+}
+
+// matchIDs returns the IDs of all matches with a snapshot file
+func (s *Store) matchIDs() ([]uint32, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history directory: %v", err)
+	}
+
+	var ids []uint32
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, fileExt), 10, 32)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids, nil
+}
+
+// scan calls fn for each non-empty line of a match file
+func (s *Store) scan(matchID uint32, fn func(line []byte) error) error {
+	f, err := os.Open(s.path(matchID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to open history file: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read history file: %v", err)
+	}
+	return nil
+}
+
+// readLastHash returns the hash of the newest snapshot of a match on disk
+func (s *Store) readLastHash(matchID uint32) (string, error) {
+	var hash string
+	err := s.scan(matchID, func(line []byte) error {
+		var snap struct {
+			Hash string `json:"hash"`
+		}
+		if err := json.Unmarshal(line, &snap); err == nil {
+			hash = snap.Hash
+		}
+		return nil
+	})
+	return hash, err
+}
+
+// hashMatch fingerprints the match data, ignoring when it was fetched
+func hashMatch(match models.MatchInfo) (string, error) {
+	match.LastUpdated = time.Time{}
+	data, err := json.Marshal(match)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode match: %v", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// displayName returns a human readable name for a stored match
+func displayName(match models.MatchInfo) string {
+	if match.MatchShortName != "" {
+		return match.MatchShortName
+	}
+	header := match.CricbuzzInfo.MatchHeader
+	return fmt.Sprintf("%s vs %s", header.Team1.ShortName, header.Team2.ShortName)
+}
@@ -0,0 +1,268 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/yannlawrency/crictty/internal/models"
+)
+
+// base is the time the first snapshot of every test match is taken
+var base = time.Date(2025, 11, 22, 4, 0, 0, 0, time.UTC)
+
+// snapshotOf returns a match as fetched some minutes after base
+func snapshotOf(matchID uint32, status string, minutes int) models.MatchInfo {
+	match := models.MatchInfo{
+		MatchShortName:  "IND vs AUS",
+		CricbuzzMatchID: matchID,
+		LastUpdated:     base.Add(time.Duration(minutes) * time.Minute),
+	}
+	match.CricbuzzInfo.MatchHeader.MatchFormat = "TEST"
+	match.CricbuzzInfo.MatchHeader.Status = status
+	return match
+}
+
+// openStore opens a store in a fresh directory
+func openStore(t *testing.T) *Store {
+	t.Helper()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return s
+}
+
+func TestSave(t *testing.T) {
+	tests := []struct {
+		name    string
+		matches []models.MatchInfo
+		written []bool
+	}{
+		{
+			name:    "first snapshot",
+			matches: []models.MatchInfo{snapshotOf(1, "Day 1: Stumps", 0)},
+			written: []bool{true},
+		},
+		{
+			name: "same match fetched again",
+			matches: []models.MatchInfo{
+				snapshotOf(1, "Day 1: Stumps", 0),
+				snapshotOf(1, "Day 1: Stumps", 5),
+			},
+			written: []bool{true, false},
+		},
+		{
+			name: "match changed",
+			matches: []models.MatchInfo{
+				snapshotOf(1, "Day 1: Stumps", 0),
+				snapshotOf(1, "Day 2: Lunch", 5),
+				snapshotOf(1, "Day 1: Stumps", 10),
+			},
+			written: []bool{true, true, true},
+		},
+		{
+			name: "matches deduplicated on their own",
+			matches: []models.MatchInfo{
+				snapshotOf(1, "Day 1: Stumps", 0),
+				snapshotOf(2, "Day 1: Stumps", 0),
+				snapshotOf(1, "Day 1: Stumps", 5),
+			},
+			written: []bool{true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := openStore(t)
+			for i, match := range tt.matches {
+				written, err := s.Save(match)
+				if err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+				if written != tt.written[i] {
+					t.Errorf("Save() of snapshot %d = %v, want %v", i, written, tt.written[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSaveReadsLastHashFromDisk(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if _, err := s.Save(snapshotOf(1, "Day 1: Stumps", 0)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// A new store has to pick up the hash from the file
+	reopened, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	written, err := reopened.Save(snapshotOf(1, "Day 1: Stumps", 5))
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if written {
+		t.Error("Save() after reopening wrote a duplicate snapshot")
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	s := openStore(t)
+	for _, match := range []models.MatchInfo{
+		snapshotOf(1, "Day 1: Lunch", 10),
+		snapshotOf(1, "Day 1: Stumps", 30),
+		snapshotOf(1, "Toss", 0),
+		snapshotOf(2, "Day 1: Stumps", 20),
+	} {
+		if _, err := s.Save(match); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		matchID uint32
+		want    []string
+	}{
+		{name: "oldest first", matchID: 1, want: []string{"Toss", "Day 1: Lunch", "Day 1: Stumps"}},
+		{name: "other match", matchID: 2, want: []string{"Day 1: Stumps"}},
+		{name: "no history", matchID: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshots, err := s.Snapshots(tt.matchID)
+			if err != nil {
+				t.Fatalf("Snapshots() error = %v", err)
+			}
+			var got []string
+			for _, snap := range snapshots {
+				got = append(got, snap.Match.CricbuzzInfo.MatchHeader.Status)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Snapshots() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	s := openStore(t)
+	for _, match := range []models.MatchInfo{
+		snapshotOf(1, "Toss", 0),
+		snapshotOf(2, "Toss", 5),
+		snapshotOf(1, "Day 1: Stumps", 60),
+	} {
+		if _, err := s.Save(match); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	// Files that are not snapshots are left alone
+	if err := os.WriteFile(filepath.Join(s.Dir(), "notes.txt"), []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	summaries, err := s.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := []Summary{
+		{MatchID: 1, Name: "IND vs AUS", Format: "TEST", Status: "Day 1: Stumps", Snapshots: 2, First: base, Last: base.Add(60 * time.Minute)},
+		{MatchID: 2, Name: "IND vs AUS", Format: "TEST", Status: "Toss", Snapshots: 1, First: base.Add(5 * time.Minute), Last: base.Add(5 * time.Minute)},
+	}
+	if len(summaries) != len(want) {
+		t.Fatalf("List() = %+v, want %+v", summaries, want)
+	}
+	for i := range want {
+		got := summaries[i]
+		if got.MatchID != want[i].MatchID || got.Name != want[i].Name || got.Format != want[i].Format ||
+			got.Status != want[i].Status || got.Snapshots != want[i].Snapshots ||
+			!got.First.Equal(want[i].First) || !got.Last.Equal(want[i].Last) {
+			t.Errorf("summary %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		name   string
+		cutoff time.Time
+		pruned []uint32
+		left   []uint32
+	}{
+		{name: "nothing older", cutoff: base, left: []uint32{3, 2, 1}},
+		{name: "older matches", cutoff: base.Add(90 * time.Minute), pruned: []uint32{1}, left: []uint32{3, 2}},
+		{name: "latest snapshot counts", cutoff: base.Add(150 * time.Minute), pruned: []uint32{1}, left: []uint32{3, 2}},
+		{name: "everything", cutoff: base.Add(24 * time.Hour), pruned: []uint32{3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := openStore(t)
+			for _, match := range []models.MatchInfo{
+				snapshotOf(1, "Toss", 0),
+				snapshotOf(2, "Toss", 60),
+				snapshotOf(2, "Day 1: Stumps", 180),
+				snapshotOf(3, "Toss", 240),
+			} {
+				if _, err := s.Save(match); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+			}
+
+			pruned, err := s.Prune(tt.cutoff)
+			if err != nil {
+				t.Fatalf("Prune() error = %v", err)
+			}
+			if !slices.Equal(pruned, tt.pruned) {
+				t.Errorf("Prune() = %v, want %v", pruned, tt.pruned)
+			}
+
+			summaries, err := s.List()
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			var left []uint32
+			for _, summary := range summaries {
+				left = append(left, summary.MatchID)
+			}
+			if !slices.Equal(left, tt.left) {
+				t.Errorf("matches left = %v, want %v", left, tt.left)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := openStore(t)
+	if _, err := s.Save(snapshotOf(1, "Toss", 0)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if err := s.Delete(1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if snapshots, err := s.Snapshots(1); err != nil || len(snapshots) != 0 {
+		t.Errorf("Snapshots() after Delete() = %v, %v, want none", snapshots, err)
+	}
+	if err := s.Delete(1); err == nil {
+		t.Error("Delete() of a missing match succeeded")
+	}
+
+	// The same snapshot is written again once the history is gone
+	written, err := s.Save(snapshotOf(1, "Toss", 5))
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if !written {
+		t.Error("Save() after Delete() skipped the snapshot")
+	}
+}
@@ -0,0 +1,493 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+	"github.com/yannlawrency/crictty/internal/stats"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// chartView is what the scorecard area of the match view shows
+type chartView int
+
+// Views of the scorecard area, in the order the chart key cycles through
+const (
+	chartNone chartView = iota
+	chartManhattan
+	chartWorm
+	chartPartnerships
+	chartDays
+	chartViews
+)
+
+// Heights of the charts in rows
+const (
+	manhattanHeight = 8
+	wormHeight      = 10
+)
+
+// axisWidth is the width of the runs axis on the left of a chart
+const axisWidth = 5
+
+// barBlocks are the eighths of a Manhattan bar, lowest first
+var barBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// seriesColors tell the innings of a worm apart
+var seriesColors = []lipgloss.Color{"12", "11", "13", "14"}
+
+// barStyle colours the bars of a Manhattan
+var barStyle = lipgloss.NewStyle().Foreground(seriesColors[0])
+
+// chartInnings returns the innings of a match in the order they were played
+// with their over-by-over runs
+func (m Model) chartInnings(match models.MatchInfo) ([]models.InningsScore, [][]stats.Over) {
+	innings := match.CricbuzzInfo.Miniscore.MatchScoreDetails.Innings()
+	timeline := m.timelines[match.CricbuzzMatchID]
+	perOver := models.BallsPerOver(match.CricbuzzInfo.MatchHeader.MatchFormat)
+
+	overs := make([][]stats.Over, len(innings))
+	for i, inn := range innings {
+		overs[i] = stats.OverByOver(timeline, inn.InningsID, perOver)
+	}
+	return innings, overs
+}
+
+// nextChart returns the view after the current one, skipping the days of
+// play for limited-overs matches
+func (m Model) nextChart(match models.MatchInfo) chartView {
+	next := (m.chart + 1) % chartViews
+	if next == chartDays && stats.IsLimitedOvers(match.CricbuzzInfo.MatchHeader.MatchFormat) {
+		next = (next + 1) % chartViews
+	}
+	return next
+}
+
+// fitChart goes back to the scorecard when the selected match has no tab for
+// the current chart, like the days of play of a limited-overs match
+func (m *Model) fitChart(match models.MatchInfo) {
+	if m.chart == chartDays && stats.IsLimitedOvers(match.CricbuzzInfo.MatchHeader.MatchFormat) {
+		m.chart = chartNone
+	}
+}
+
+// renderCharts renders the chart selected for the match in place of the scorecard
+func (m Model) renderCharts(match models.MatchInfo) string {
+	var content strings.Builder
+
+	innings, overs := m.chartInnings(match)
+	tabs := m.renderChartTabs(match)
+	indicatorWidth := m.layout.scorecardWidth - lipgloss.Width(tabs)
+	indicator := ""
+	if m.chart != chartWorm && m.chart != chartDays {
+		indicator = m.renderInningsIndicator(match, m.currentInnings, len(innings), indicatorWidth)
+	}
+	headerRow := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.NewStyle().Width(indicatorWidth).Align(lipgloss.Left).Render(indicator),
+		tabs,
+	)
+	content.WriteString(fmt.Sprintf("\n%s\n", headerRow))
+
+	var chart string
+	switch m.chart {
+	case chartManhattan:
+		if m.currentInnings < len(overs) {
+			chart = renderManhattan(overs[m.currentInnings], m.layout.scorecardWidth)
+		}
+	case chartWorm:
+		chart = renderWorm(innings, overs, stats.InningsBalls(match.CricbuzzInfo.MatchHeader.MatchFormat)/
+			models.BallsPerOver(match.CricbuzzInfo.MatchHeader.MatchFormat), m.layout.scorecardWidth)
+	case chartPartnerships:
+		if m.currentInnings < len(match.Scorecard) {
+			stands := stats.Partnerships(match.Scorecard[m.currentInnings],
+				scorecardInningsID(match, m.currentInnings), m.timelines[match.CricbuzzMatchID])
+			chart = renderPartnerships(stands, m.layout.scorecardWidth)
+		}
+	case chartDays:
+		chart = renderDays(stats.Days(match, m.timelines[match.CricbuzzMatchID]),
+			models.BallsPerOver(match.CricbuzzInfo.MatchHeader.MatchFormat), m.layout.scorecardWidth)
+	}
+	if chart == "" {
+		chart = helpStyle.Render("No data recorded for this innings yet")
+		if m.chart == chartDays {
+			chart = helpStyle.Render("No days of play recorded yet")
+		}
+	}
+	content.WriteString(chart)
+	content.WriteString("\n")
+
+	return content.String()
+}
+
+// renderChartTabs renders the tabs for switching between the charts
+func (m Model) renderChartTabs(match models.MatchInfo) string {
+	var tabs []string
+	for _, tab := range []struct {
+		view  chartView
+		label string
+	}{{chartManhattan, "Manhattan"}, {chartWorm, "Worm"}, {chartPartnerships, "Stands"}, {chartDays, "Days"}} {
+		if tab.view == chartDays && stats.IsLimitedOvers(match.CricbuzzInfo.MatchHeader.MatchFormat) {
+			continue
+		}
+		style := tabStyle
+		if m.chart == tab.view {
+			style = activeTabStyle
+		}
+		tabs = append(tabs, zone{zoneChartTab, int(tab.view)}.mark(style.Render(tab.label)))
+	}
+	row := lipgloss.JoinHorizontal(lipgloss.Center, tabs...)
+	return lipgloss.NewStyle().MarginBottom(1).Render(row)
+}
+
+// renderManhattan renders the runs of every over as bars, with the wickets
+// that fell in each over underneath. Overs are grouped when there are more
+// of them than columns.
+func renderManhattan(overs []stats.Over, width int) string {
+	if len(overs) == 0 {
+		return ""
+	}
+
+	// Group overs into columns and work out the bar width
+	columns := width - axisWidth
+	group := (len(overs) + columns - 1) / columns
+	var bars []stats.Over
+	for i := 0; i < len(overs); i += group {
+		bar := stats.Over{Number: overs[i].Number}
+		for _, over := range overs[i:min(i+group, len(overs))] {
+			bar.Runs += over.Runs
+			bar.Wickets += over.Wickets
+		}
+		bars = append(bars, bar)
+	}
+	slot := min(max(columns/len(bars), 1), 4)
+	barWidth := max(slot-1, 1)
+
+	top := 6
+	for _, bar := range bars {
+		top = max(top, bar.Runs)
+	}
+
+	var content strings.Builder
+	for row := manhattanHeight - 1; row >= 0; row-- {
+		switch row {
+		case manhattanHeight - 1:
+			content.WriteString(helpStyle.Render(fmt.Sprintf("%3d ┤", top)))
+		case 0:
+			content.WriteString(helpStyle.Render(fmt.Sprintf("%3d ┤", 0)))
+		default:
+			content.WriteString(helpStyle.Render("    │"))
+		}
+
+		var line strings.Builder
+		for _, bar := range bars {
+			eighths := bar.Runs*manhattanHeight*8/top - row*8
+			cell := " "
+			if eighths > 0 {
+				cell = string(barBlocks[min(eighths, 8)-1])
+			}
+			line.WriteString(strings.Repeat(cell, barWidth))
+			line.WriteString(strings.Repeat(" ", slot-barWidth))
+		}
+		content.WriteString(barStyle.Render(line.String()))
+		content.WriteString("\n")
+	}
+
+	// Axis with the wickets of each over and the over numbers
+	content.WriteString(helpStyle.Render("    └" + strings.Repeat("─", len(bars)*slot)))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat(" ", axisWidth))
+	for _, bar := range bars {
+		mark := strings.Repeat(" ", barWidth)
+		switch {
+		case bar.Wickets == 1:
+			mark = wicketStyle.Render(fmt.Sprintf("%-*s", barWidth, "W"))
+		case bar.Wickets > 1:
+			mark = wicketStyle.Render(fmt.Sprintf("%-*d", barWidth, bar.Wickets))
+		}
+		content.WriteString(mark + strings.Repeat(" ", slot-barWidth))
+	}
+	content.WriteString("\n")
+	content.WriteString(helpStyle.Render(overLabels(bars, slot, group)))
+
+	return content.String()
+}
+
+// overLabels numbers the columns of a Manhattan every few overs
+func overLabels(bars []stats.Over, slot, group int) string {
+	labels := []rune(strings.Repeat(" ", axisWidth+len(bars)*slot+3))
+	every := 5 * group
+	if slot == 1 {
+		every *= 2
+	}
+	for i, bar := range bars {
+		end := bar.Number + group - 1
+		if end%every != 0 {
+			continue
+		}
+		label := []rune(fmt.Sprint(end))
+		copy(labels[axisWidth+i*slot:], label)
+	}
+	return strings.TrimRight(string(labels), " ")
+}
+
+// renderWorm renders the cumulative runs of every innings on the same axes,
+// drawing two points per row with half blocks
+func renderWorm(innings []models.InningsScore, overs [][]stats.Over, maxOvers, width int) string {
+	top := 0
+	for i := range overs {
+		maxOvers = max(maxOvers, len(overs[i]))
+		if n := len(overs[i]); n > 0 {
+			top = max(top, overs[i][n-1].Total)
+		}
+	}
+	if top == 0 || maxOvers == 0 {
+		return ""
+	}
+
+	// Plot every innings on a grid of half rows, later innings on top
+	columns := width - axisWidth
+	levels := wormHeight * 2
+	grid := make([][]int, levels)
+	for level := range grid {
+		grid[level] = make([]int, columns)
+		for col := range grid[level] {
+			grid[level][col] = -1
+		}
+	}
+	for s, series := range overs {
+		previous := 0
+		for col := 0; col < columns; col++ {
+			over := float64(col) * float64(maxOvers) / float64(columns-1)
+			if over > float64(len(series)) {
+				break
+			}
+			level := int(totalAt(series, over)/float64(top)*float64(levels-1) + 0.5)
+			for l := min(previous, level); l <= max(previous, level); l++ {
+				grid[l][col] = s
+			}
+			previous = level
+		}
+	}
+
+	var content strings.Builder
+	for row := wormHeight - 1; row >= 0; row-- {
+		switch row {
+		case wormHeight - 1:
+			content.WriteString(helpStyle.Render(fmt.Sprintf("%3d ┤", top)))
+		case 0:
+			content.WriteString(helpStyle.Render(fmt.Sprintf("%3d ┤", 0)))
+		default:
+			content.WriteString(helpStyle.Render("    │"))
+		}
+		for col := 0; col < columns; col++ {
+			content.WriteString(halfBlock(grid[row*2+1][col], grid[row*2][col]))
+		}
+		content.WriteString("\n")
+	}
+	content.WriteString(helpStyle.Render("    └" + strings.Repeat("─", columns)))
+	content.WriteString("\n")
+	content.WriteString(helpStyle.Render(fmt.Sprintf("%*s%*s", axisWidth+1, "0", columns-1, fmt.Sprintf("%d ov", maxOvers))))
+	content.WriteString("\n")
+
+	// Legend with the score of every innings
+	var legend []string
+	for i, inn := range innings {
+		style := lipgloss.NewStyle().Foreground(seriesColors[i%len(seriesColors)])
+		legend = append(legend, style.Render("■")+" "+fmt.Sprintf("%s %d/%d", inn.BatTeamName, inn.Score, inn.Wickets))
+	}
+	content.WriteString(lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(strings.Join(legend, "   ")))
+
+	return content.String()
+}
+
+// totalAt returns the runs of an innings after a fractional number of overs
+func totalAt(series []stats.Over, over float64) float64 {
+	whole := int(over)
+	before := 0.0
+	if whole > 0 {
+		before = float64(series[min(whole, len(series))-1].Total)
+	}
+	if whole >= len(series) {
+		return before
+	}
+	return before + (over-float64(whole))*float64(series[whole].Runs)
+}
+
+// halfBlock draws a cell of the worm from the series in its top and bottom
+// halves, -1 when a half is empty
+func halfBlock(top, bottom int) string {
+	color := func(s int) lipgloss.Color { return seriesColors[s%len(seriesColors)] }
+	switch {
+	case top < 0 && bottom < 0:
+		return " "
+	case top == bottom:
+		return lipgloss.NewStyle().Foreground(color(top)).Render("█")
+	case bottom < 0:
+		return lipgloss.NewStyle().Foreground(color(top)).Render("▀")
+	case top < 0:
+		return lipgloss.NewStyle().Foreground(color(bottom)).Render("▄")
+	default:
+		return lipgloss.NewStyle().Foreground(color(top)).Background(color(bottom)).Render("▀")
+	}
+}
+
+// Colours of the two batters in a partnership bar
+var (
+	firstBatterStyle  = lipgloss.NewStyle().Foreground(seriesColors[0])
+	secondBatterStyle = lipgloss.NewStyle().Foreground(seriesColors[1])
+)
+
+// minStandBar is the width a partnership bar keeps before names are cut
+const minStandBar = 10
+
+// renderPartnerships renders every stand of an innings as a bar split
+// between the two batters, scaled to the biggest stand. The rest of a bar
+// is extras or runs that could not be put down to either batter.
+func renderPartnerships(stands []stats.Partnership, width int) string {
+	if len(stands) == 0 {
+		return ""
+	}
+
+	top := 1
+	for _, stand := range stands {
+		top = max(top, stand.Total)
+	}
+
+	// Names give way to the bar on narrow screens
+	nameWidth := min(16, max((width-2*5-minStandBar)/2, 4))
+	barWidth := max(width-2*nameWidth-2*5, 0)
+	var content strings.Builder
+	for i, stand := range stands {
+		if i > 0 {
+			content.WriteString("\n")
+		}
+
+		// Wicket, runs and balls of the stand and how it ended
+		ended := "unbroken"
+		if !stand.Unbroken() {
+			ended = stand.EndedBy + " out"
+		}
+		summary := fmt.Sprintf("%s wkt  %d (%d)", stats.Ordinal(stand.Wicket), stand.Total, stand.Balls)
+		content.WriteString(scoreStyle.Render(summary))
+		content.WriteString(helpStyle.Render(fmt.Sprintf("%*s", width-lipgloss.Width(summary), truncateString(ended, width/2))))
+		content.WriteString("\n")
+
+		// Bar split between the batters
+		length := stand.Total * barWidth / top
+		first, second := 0, 0
+		if stand.Total > 0 {
+			first = max(stand.Runs[0], 0) * length / stand.Total
+			second = max(stand.Runs[1], 0) * length / stand.Total
+		}
+		rest := max(length-first-second, 0)
+		bar := firstBatterStyle.Render(strings.Repeat("█", first)) +
+			secondBatterStyle.Render(strings.Repeat("█", second)) +
+			helpStyle.Render(strings.Repeat("░", rest)) +
+			strings.Repeat(" ", max(barWidth-first-second-rest, 0))
+
+		content.WriteString(fmt.Sprintf("%*s %4s ", nameWidth-1, truncateString(stand.Batters[0], nameWidth-1), formatStandRuns(stand.Runs[0])))
+		content.WriteString(bar)
+		content.WriteString(fmt.Sprintf(" %-4s %-*s", formatStandRuns(stand.Runs[1]), nameWidth-1, truncateString(stand.Batters[1], nameWidth-1)))
+	}
+	return content.String()
+}
+
+// formatStandRuns formats the runs of a batter in a stand, "-" when unknown
+func formatStandRuns(runs int) string {
+	if runs < 0 {
+		return "-"
+	}
+	return fmt.Sprint(runs)
+}
+
+// renderDays renders the runs and wickets of every session of a multi-day
+// match, with the runs of each day and the score at the close. Narrow widths
+// drop the overs from the cells, then the day total column.
+func renderDays(days []stats.Day, perOver, width int) string {
+	if len(days) == 0 {
+		return ""
+	}
+
+	sessions := 3
+	for _, day := range days {
+		for _, session := range day.Sessions {
+			sessions = max(sessions, session.Number)
+		}
+	}
+
+	// The day number and the header padding take 7 columns, cells with the
+	// overs need the full cell width
+	const maxCellWidth, minCellWidth = 13, 8
+	columns := sessions + 2
+	cellWidth := min((width-7)/columns, maxCellWidth)
+	showTotal := cellWidth >= minCellWidth
+	if !showTotal {
+		columns--
+		cellWidth = min((width-7)/columns, maxCellWidth)
+	}
+	showOvers := cellWidth == maxCellWidth
+	format := func(runs, wickets, balls int) string {
+		if showOvers {
+			return formatDayScore(runs, wickets, balls, perOver)
+		}
+		return fmt.Sprintf("%d/%d", runs, wickets)
+	}
+
+	var content strings.Builder
+	header := fmt.Sprintf("%-5s", "Day")
+	for n := 1; n <= sessions; n++ {
+		label := fmt.Sprintf("Session %d", n)
+		if !showOvers {
+			label = fmt.Sprintf("S%d", n)
+		}
+		header += fmt.Sprintf("%-*s", cellWidth, label)
+	}
+	if showTotal {
+		label := "Day total"
+		if !showOvers {
+			label = "Day"
+		}
+		header += fmt.Sprintf("%-*s", cellWidth, label)
+	}
+	header += fmt.Sprintf("%-*s", cellWidth, "Close")
+	content.WriteString(tableHeaderStyle.Render(header))
+
+	for _, day := range days {
+		cells := make([]string, sessions)
+		for _, session := range day.Sessions {
+			cells[session.Number-1] = format(session.Runs, session.Wickets, session.Balls)
+		}
+		number := fmt.Sprint(day.Number)
+		if day.Partial {
+			number += "*"
+		}
+		row := fmt.Sprintf(" %-5s", number)
+		for _, cell := range cells {
+			row += fmt.Sprintf("%-*s", cellWidth, cell)
+		}
+		if showTotal {
+			row += fmt.Sprintf("%-*s", cellWidth, format(day.Runs, day.Wickets, day.Balls))
+		}
+		content.WriteString("\n")
+		content.WriteString(row)
+		score := fmt.Sprintf("%d/%d", day.End.Runs, day.End.Wickets)
+		content.WriteString(scoreStyle.Render(score))
+		breakWidth := max(cellWidth-len(score)-1, 0)
+		content.WriteString(helpStyle.Render(" " + fmt.Sprintf("%-*s", breakWidth, truncateString(day.End.Break, breakWidth))))
+	}
+	if slices.ContainsFunc(days, func(day stats.Day) bool { return day.Partial }) {
+		content.WriteString("\n\n")
+		content.WriteString(helpStyle.Render(truncateString("* only counts from the first update seen that day", width)))
+	}
+	return content.String()
+}
+
+// formatDayScore formats the runs and wickets of a day or session with the
+// overs they took, e.g. "98/2 (28.4)"
+func formatDayScore(runs, wickets, balls, perOver int) string {
+	return fmt.Sprintf("%d/%d (%s)", runs, wickets, models.BallsToOvers(balls, perOver))
+}
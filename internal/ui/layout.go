@@ -0,0 +1,83 @@
+package ui
+
+// Widths the layout adapts to, in terminal columns
+const (
+	// defaultWidth is the width of the content before the terminal size is
+	// known
+	defaultWidth = 65
+
+	// minWidth and maxWidth bound the content column, wider content only
+	// spreads the live panel apart
+	minWidth = 40
+	maxWidth = 100
+
+	// narrowWidth is the width below which scorecards drop their low
+	// priority columns
+	narrowWidth = 65
+
+	// wideWidth is the terminal width from which the live panel, batting
+	// card and bowling card sit side by side, up to maxWideWidth
+	wideWidth    = 190
+	maxWideWidth = 240
+
+	// cardGap separates the columns shown side by side
+	cardGap = 2
+)
+
+// layout is the size of the parts of the match view, worked out from the
+// terminal size
+type layout struct {
+	// width is the width of the header and live panel
+	width int
+
+	// scorecardWidth is the width of the scorecard and charts below the
+	// live panel, or beside it when the layout is wide
+	scorecardWidth int
+
+	// cardWidth is the width of a batting or bowling card, half the
+	// scorecard when they are side by side
+	cardWidth int
+
+	// wide is set when the live panel and the batting and bowling cards
+	// are side by side
+	wide bool
+}
+
+// newLayout returns the layout for a terminal of the given width, or the
+// default one when the width is not known yet
+func newLayout(termWidth int) layout {
+	if termWidth <= 0 {
+		return layout{width: defaultWidth, scorecardWidth: defaultWidth, cardWidth: defaultWidth}
+	}
+
+	// Leave a margin on each side of the content
+	available := termWidth - 4
+	width := max(min(available, maxWidth), minWidth)
+	if termWidth >= wideWidth {
+		// The live panel takes a third, leaving the cards room for all
+		// their columns
+		total := min(available, maxWideWidth)
+		width = min(max(total/3, minWidth), maxWidth, total-2*(narrowWidth+cardGap))
+		scorecardWidth := total - width - cardGap
+		return layout{
+			width:          width,
+			scorecardWidth: scorecardWidth,
+			cardWidth:      (scorecardWidth - cardGap) / 2,
+			wide:           true,
+		}
+	}
+	return layout{width: width, scorecardWidth: width, cardWidth: width}
+}
+
+// narrow reports whether scorecards of the given width drop their low
+// priority columns
+func narrow(width int) bool {
+	return width < narrowWidth
+}
+
+// resize applies a new terminal size to the model
+func (m *Model) resize(width, height int) {
+	m.width = width
+	m.height = height
+	m.layout = newLayout(width)
+}
@@ -0,0 +1,239 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yannlawrency/crictty/internal/app"
+	"github.com/yannlawrency/crictty/internal/cricbuzz"
+	"github.com/yannlawrency/crictty/internal/models"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Backoff between attempts when the first load fails
+const (
+	minRetryBackoff = 1 * time.Second
+	maxRetryBackoff = 1 * time.Minute
+)
+
+// retryKey retries a failed load straight away
+var retryKey = key.NewBinding(
+	key.WithKeys("r"),
+	key.WithHelp("r", "retry now"),
+)
+
+// targetsMsg carries the matches to load on startup
+type targetsMsg struct {
+	targets []cricbuzz.Fixture
+	err     error
+}
+
+// matchLoadedMsg carries a single match loaded on startup along with its
+// recorded history
+type matchLoadedMsg struct {
+	matchID uint32
+	match   models.MatchInfo
+	history []models.MatchInfo
+	err     error
+}
+
+// historyLoadedMsg carries the recorded history of a match that showed up
+// after startup
+type historyLoadedMsg struct {
+	matchID uint32
+	history []models.MatchInfo
+}
+
+// retryMsg retries a failed load. The generation ties it to the failure that
+// scheduled it, so a manual retry cancels the pending one.
+type retryMsg struct{ generation int }
+
+// loadTargetsCmd lists the matches to load in the background
+func loadTargetsCmd(a *app.App) tea.Cmd {
+	return func() tea.Msg {
+		targets, err := a.Targets()
+		return targetsMsg{targets: targets, err: err}
+	}
+}
+
+// loadMatchCmd loads a single match in the background
+func loadMatchCmd(a *app.App, target cricbuzz.Fixture) tea.Cmd {
+	return func() tea.Msg {
+		history := a.History(target.MatchID)
+		match, err := a.Fetch(target)
+		return matchLoadedMsg{matchID: target.MatchID, match: match, history: history, err: err}
+	}
+}
+
+// loadHistoryCmd reads the recorded history of a match in the background
+func loadHistoryCmd(a *app.App, matchID uint32) tea.Cmd {
+	return func() tea.Msg {
+		return historyLoadedMsg{matchID: matchID, history: a.History(matchID)}
+	}
+}
+
+// loadNewHistories loads the recorded history of the matches not seen
+// before, so they are seeded once it arrives
+func (m *Model) loadNewHistories(matches []models.MatchInfo) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, match := range matches {
+		if _, ok := m.timelines[match.CricbuzzMatchID]; !ok {
+			cmds = append(cmds, loadHistoryCmd(m.app, match.CricbuzzMatchID))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// startLoading lists the matches to load
+func (m *Model) startLoading() tea.Cmd {
+	m.loading = true
+	return loadTargetsCmd(m.app)
+}
+
+// handleTargets starts loading every listed match, each filling in its tab
+// as soon as it arrives
+func (m *Model) handleTargets(msg targetsMsg) tea.Cmd {
+	if !m.loading {
+		return nil
+	}
+	if msg.err != nil && len(msg.targets) == 0 {
+		return m.loadFailed(msg.err)
+	}
+
+	m.targets = msg.targets
+	m.pending = len(msg.targets)
+	m.loadErr = msg.err
+	if m.pending == 0 {
+		return m.loadDone()
+	}
+
+	cmds := make([]tea.Cmd, len(msg.targets))
+	for i, target := range msg.targets {
+		cmds[i] = loadMatchCmd(m.app, target)
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleMatchLoaded adds a loaded match in its place among the targets
+func (m *Model) handleMatchLoaded(msg matchLoadedMsg) tea.Cmd {
+	if !m.loading {
+		return nil
+	}
+
+	m.pending--
+	if msg.err != nil {
+		m.loadErr = msg.err
+	} else if m.app.Watching(msg.matchID) {
+		m.seedHistory(msg.matchID, msg.history)
+		m.upsertMatch(msg.match)
+	}
+
+	if m.pending > 0 {
+		return nil
+	}
+	if len(m.matches) == 0 && m.loadErr != nil {
+		return m.loadFailed(m.loadErr)
+	}
+	return m.loadDone()
+}
+
+// upsertMatch replaces a match in the snapshot, or inserts it in target order
+func (m *Model) upsertMatch(match models.MatchInfo) {
+	order := func(id uint32) int {
+		i := slices.IndexFunc(m.targets, func(t cricbuzz.Fixture) bool { return t.MatchID == id })
+		if i < 0 {
+			return len(m.targets)
+		}
+		return i
+	}
+
+	matches := slices.Clone(m.matches)
+	if i := slices.IndexFunc(matches, func(other models.MatchInfo) bool {
+		return other.CricbuzzMatchID == match.CricbuzzMatchID
+	}); i >= 0 {
+		matches[i] = match
+	} else {
+		at := len(matches)
+		for i, other := range matches {
+			if order(other.CricbuzzMatchID) > order(match.CricbuzzMatchID) {
+				at = i
+				break
+			}
+		}
+		matches = slices.Insert(matches, at, match)
+	}
+
+	if m.selectedID == 0 {
+		m.selectedID = match.CricbuzzMatchID
+	}
+	m.applyMatches(matches)
+}
+
+// loadDone ends the startup load and starts the refresh ticks
+func (m *Model) loadDone() tea.Cmd {
+	m.loading = false
+	m.loadErr = nil
+	m.backoff = 0
+	return m.scheduleTick()
+}
+
+// loadFailed schedules another attempt, doubling the wait every time
+func (m *Model) loadFailed(err error) tea.Cmd {
+	m.loadErr = err
+	m.backoff = min(max(m.backoff*2, minRetryBackoff), maxRetryBackoff)
+	m.retryAt = time.Now().Add(m.backoff)
+	m.retryGeneration++
+
+	generation := m.retryGeneration
+	return tea.Tick(m.backoff, func(time.Time) tea.Msg {
+		return retryMsg{generation}
+	})
+}
+
+// retryNow cancels the pending retry and loads again straight away
+func (m *Model) retryNow() tea.Cmd {
+	m.retryGeneration++
+	m.retryAt = time.Time{}
+	return m.startLoading()
+}
+
+// renderLoading renders the loading screen shown until the first match arrives
+func (m Model) renderLoading() string {
+	var content strings.Builder
+
+	content.WriteString("\n")
+	content.WriteString(scoreStyle.Render(fmt.Sprintf("%s Fetching the scoreboard", m.spinner.View())))
+	content.WriteString("\n")
+
+	if m.loadErr != nil && !m.retryAt.IsZero() {
+		wait := max(time.Until(m.retryAt).Round(time.Second), 0)
+		content.WriteString("\n")
+		content.WriteString(statusStyle.Render(fmt.Sprintf("Failed to load: %v", m.loadErr)))
+		content.WriteString("\n\n")
+		content.WriteString(helpStyle.Render(fmt.Sprintf("Retrying in %s • r: retry now • q: quit", wait)))
+		content.WriteString("\n")
+	} else {
+		content.WriteString("\n")
+		content.WriteString(helpStyle.Render("q: quit"))
+		content.WriteString("\n")
+	}
+
+	return lipgloss.NewStyle().
+		Width(m.width).
+		MarginTop(max((m.height-8)/2, 0)).
+		Align(lipgloss.Center).
+		Render(content.String())
+}
+
+// renderLoadingMore renders a line telling how many matches are still loading
+func (m Model) renderLoadingMore() string {
+	if !m.loading || m.pending == 0 {
+		return ""
+	}
+	return helpStyle.Render(fmt.Sprintf("%s Loading %d more match(es)", m.spinner.View(), m.pending)) + "\n"
+}
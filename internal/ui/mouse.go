@@ -0,0 +1,32 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// updateMouse handles clicks on the match, innings, batting/bowling and
+// chart tabs, highlights the scorecard row under the mouse and scrolls the
+// scorecard with the wheel
+func (m *Model) updateMouse(msg tea.MouseMsg) {
+	if tea.MouseEvent(msg).IsWheel() {
+		m.scrollWheel(msg)
+		return
+	}
+
+	at, _ := m.zones.at(msg.X, msg.Y)
+	m.hovered = at
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return
+	}
+
+	switch at.kind {
+	case zoneMatchTab:
+		m.selectMatch(at.index)
+	case zoneInningsTab:
+		m.currentInnings = at.index
+	case zoneScorecardTab:
+		m.showBowling = at.index == 1
+	case zoneChartTab:
+		m.chart = chartView(at.index)
+	}
+}
@@ -0,0 +1,448 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+	"github.com/yannlawrency/crictty/internal/stats"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// renderChase renders the chase equation of a limited-overs second innings
+func (m Model) renderChase(match models.MatchInfo) string {
+	chase, ok := stats.ComputeChase(match, m.timelines[match.CricbuzzMatchID])
+	if !ok || match.IsFinished() || chase.Needed == 0 || chase.BallsLeft == 0 || chase.WicketsLeft == 0 {
+		return ""
+	}
+
+	var content strings.Builder
+	center := lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center)
+
+	equation := fmt.Sprintf("Target %d • Need %d off %d balls • %d wkts left",
+		chase.Target, chase.Needed, chase.BallsLeft, chase.WicketsLeft)
+	content.WriteString("\n")
+	content.WriteString(center.Render(scoreStyle.Render(equation)))
+	content.WriteString("\n")
+
+	rates := fmt.Sprintf("RRR %.2f • CRR %.2f", chase.RequiredRate, chase.CurrentRate)
+	rrrStyle := onlineStyle
+	if chase.RequiredRate > chase.CurrentRate {
+		rrrStyle = warningStyle
+	}
+	line := rrrStyle.Render(rates)
+
+	if chase.HasTrend {
+		arrow := "→"
+		switch {
+		case chase.RequiredRate > chase.PastRequiredRate+0.005:
+			arrow = "↑"
+		case chase.RequiredRate < chase.PastRequiredRate-0.005:
+			arrow = "↓"
+		}
+		line += helpStyle.Render(fmt.Sprintf(" • %s from %.2f, %d runs in last 5 ov",
+			arrow, chase.PastRequiredRate, chase.RecentRuns))
+	}
+	content.WriteString(center.Render(line))
+	content.WriteString("\n")
+
+	return content.String()
+}
+
+// renderProjection renders the projected totals of a limited-overs first innings
+func (m Model) renderProjection(match models.MatchInfo) string {
+	projection, ok := stats.ProjectScore(match)
+	if !ok || match.IsFinished() || projection.BallsLeft == 0 {
+		return ""
+	}
+
+	type column struct {
+		label string
+		total int
+	}
+	var columns []column
+	for i, score := range projection.Scores {
+		label := fmt.Sprintf("@%.2f", score.Rate)
+		if i == 0 {
+			label = "CRR " + label[1:]
+		}
+		columns = append(columns, column{label, score.Total})
+	}
+	columns = append(columns, column{"Wkts adj.", projection.WicketsAdjusted})
+
+	// Drop the highest rates until the table fits
+	for len(columns) > 2 && 10*(len(columns)+1) > m.layout.width {
+		columns = slices.Delete(columns, len(columns)-2, len(columns)-1)
+	}
+
+	header := []string{fmt.Sprintf("%-10s", "Projected")}
+	totals := []string{fmt.Sprintf("%-10s", "")}
+	for _, col := range columns {
+		header = append(header, fmt.Sprintf("%10s", col.label))
+		totals = append(totals, fmt.Sprintf("%10d", col.total))
+	}
+
+	center := lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center)
+	return "\n" +
+		center.Render(helpStyle.Render(strings.Join(header, ""))) + "\n" +
+		center.Render(scoreStyle.Render(strings.Join(totals, ""))) + "\n"
+}
+
+// renderParScore renders the DLS revised target and par score of a chase
+// whose overs were reduced
+func (m Model) renderParScore(match models.MatchInfo) string {
+	par, ok := stats.ComputeParScore(match, m.timelines[match.CricbuzzMatchID])
+	if !ok {
+		return ""
+	}
+
+	center := lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center)
+	overs := models.BallsToOvers(par.Balls, models.BallsPerOver(match.CricbuzzInfo.MatchHeader.MatchFormat))
+	line := scoreStyle.Render(fmt.Sprintf("DLS target %d from %s ov", par.Target, overs))
+	if !match.IsFinished() && par.Runs < par.Target {
+		aheadStyle, position := onlineStyle, "ahead"
+		if par.Ahead() < 0 {
+			aheadStyle, position = warningStyle, "behind"
+		}
+		line += helpStyle.Render(fmt.Sprintf(" • Par %d • ", par.Par)) +
+			aheadStyle.Render(fmt.Sprintf("%d %s", abs(par.Ahead()), position))
+	}
+	resources := fmt.Sprintf("Resources %.1f%% v %.1f%%", par.FirstResources, par.SecondResources)
+	return "\n" + center.Render(line) + "\n" + center.Render(helpStyle.Render(resources)) + "\n"
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// sparkWidth is the most characters a sparkline takes
+const sparkWidth = 24
+
+// sparkBlocks are the levels of a sparkline, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// renderWinProbability renders the chance of each side winning a
+// limited-overs match, with the history of the side batting first
+func (m Model) renderWinProbability(match models.MatchInfo) string {
+	if match.IsFinished() {
+		return ""
+	}
+	timeline := m.timelines[match.CricbuzzMatchID]
+	win, ok := stats.ComputeWinProbability(match, timeline)
+	if !ok {
+		return ""
+	}
+
+	line := helpStyle.Render("Win ") +
+		scoreStyle.Render(fmt.Sprintf("%s %.0f%%", win.BattingTeam, 100*win.Batting)) +
+		helpStyle.Render(" • ") +
+		scoreStyle.Render(fmt.Sprintf("%s %.0f%%", win.BowlingTeam, 100*win.Bowling()))
+
+	if history := stats.WinHistory(match, timeline); len(history) > 1 {
+		first := match.CricbuzzInfo.Miniscore.MatchScoreDetails.Innings()[0].BatTeamName
+		line += helpStyle.Render("   "+first+" ") + onlineStyle.Render(sparkline(history, sparkWidth))
+	}
+
+	return lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center).Render(line) + "\n"
+}
+
+// sparkline draws values between 0 and 1 in at most width characters,
+// sampling them evenly and always keeping the latest
+func sparkline(values []float64, width int) string {
+	if len(values) > width {
+		sampled := make([]float64, width)
+		for i := range sampled {
+			sampled[i] = values[(i+1)*len(values)/width-1]
+		}
+		values = sampled
+	}
+
+	var line strings.Builder
+	top := len(sparkBlocks) - 1
+	for _, v := range values {
+		level := int(v*float64(top) + 0.5)
+		line.WriteRune(sparkBlocks[min(max(level, 0), top)])
+	}
+	return line.String()
+}
+
+// Colours of the deliveries in the recent overs strip
+var (
+	dotBallStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	runsStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("7"))
+	fourStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("12")).Bold(true)
+	sixStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("13")).Bold(true)
+	wicketStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("9")).Bold(true)
+	extraStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("11"))
+	byeStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("14"))
+)
+
+// renderRecentOvers renders the recent overs as a strip of coloured balls,
+// dropping the oldest overs that do not fit
+func (m Model) renderRecentOvers(miniscore models.CricbuzzMiniscore) string {
+	overs := miniscore.RecentOvers()
+	if len(overs) == 0 {
+		return ""
+	}
+
+	separator := helpStyle.Render(" │ ")
+	var groups []string
+	width := 0
+	for i := len(overs) - 1; i >= 0; i-- {
+		balls := make([]string, len(overs[i]))
+		for j, d := range overs[i] {
+			balls[j] = renderDelivery(d)
+		}
+		group := strings.Join(balls, " ")
+		groupWidth := lipgloss.Width(group)
+		if len(groups) > 0 {
+			groupWidth += lipgloss.Width(separator)
+		}
+		if len(groups) > 0 && width+groupWidth > m.layout.width {
+			break
+		}
+		groups = append([]string{group}, groups...)
+		width += groupWidth
+	}
+
+	return lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Left).
+		Render(strings.Join(groups, separator))
+}
+
+// renderDelivery renders a single ball of the recent overs
+func renderDelivery(d models.Delivery) string {
+	runs := ""
+	if d.Runs > 0 {
+		runs = fmt.Sprint(d.Runs)
+	}
+	switch d.Kind {
+	case models.Dot:
+		return dotBallStyle.Render("•")
+	case models.Four:
+		return fourStyle.Render("4")
+	case models.Six:
+		return sixStyle.Render("6")
+	case models.Wicket:
+		return wicketStyle.Render(runs + "W")
+	case models.Wide:
+		return extraStyle.Render(runs + "wd")
+	case models.NoBall:
+		return extraStyle.Render(runs + "nb")
+	case models.LegBye:
+		return byeStyle.Render(runs + "lb")
+	case models.Bye:
+		return byeStyle.Render(runs + "b")
+	default:
+		return runsStyle.Render(runs)
+	}
+}
+
+// renderBatterControl renders the minutes, dot ball, boundary and scoring
+// shot percentages of the batters in
+func (m Model) renderBatterControl(miniscore models.CricbuzzMiniscore, control stats.Control) string {
+	batters := []models.Batsman{miniscore.BatsmanStriker, miniscore.BatsmanNonStriker}
+	if batters[0].BatBalls == 0 && batters[1].BatBalls == 0 {
+		return ""
+	}
+
+	nameWidth := m.layout.width - 4*8
+	rowFormat := fmt.Sprintf("%%-%ds%%8s%%8s%%8s%%8s", nameWidth)
+	var content strings.Builder
+	content.WriteString("\n\n")
+	content.WriteString(helpStyle.Render(fmt.Sprintf(rowFormat, "", "Mins", "Dot%", "Bdry%", "Scr%")))
+	for i, bat := range batters {
+		if bat.BatName == "" {
+			continue
+		}
+		name := bat.BatName
+		if i == 0 {
+			name += "*"
+		}
+		ctl, ok := control.Batter(miniscore.InningsID, bat.BatName)
+		if !ok {
+			ctl = stats.BatterControlOf(bat)
+		}
+		minutes := "-"
+		if ctl.HasMinutes {
+			minutes = fmt.Sprint(ctl.Minutes)
+		}
+		content.WriteString("\n")
+		content.WriteString(fmt.Sprintf(rowFormat,
+			truncateString(name, nameWidth-1),
+			minutes,
+			formatDotPercent(ctl, ctl.DotPercent()),
+			fmt.Sprintf("%.0f", ctl.BoundaryPercent()),
+			formatDotPercent(ctl, ctl.ScoringShotPercent())))
+	}
+	return content.String()
+}
+
+// renderMilestones renders the landmarks the players in are close to
+func (m Model) renderMilestones(match models.MatchInfo) string {
+	milestones := stats.Milestones(match)
+	if len(milestones) == 0 {
+		return ""
+	}
+
+	label := "Milestone watch: "
+	parts := make([]string, len(milestones))
+	for i, milestone := range milestones {
+		parts[i] = milestone.String()
+	}
+	line := truncateString(strings.Join(parts, " • "), m.layout.width-len(label))
+	return "\n" + warningStyle.Render(label) + scoreStyle.Render(line) + "\n"
+}
+
+// renderPartnership renders the stand between the batters in
+func (m Model) renderPartnership(match models.MatchInfo) string {
+	stand, ok := stats.CurrentPartnership(match, m.timelines[match.CricbuzzMatchID])
+	if !ok || (stand.Total == 0 && stand.Balls == 0) {
+		return ""
+	}
+
+	line := helpStyle.Render("Partnership ") + scoreStyle.Render(fmt.Sprintf("%d (%d)", stand.Total, stand.Balls))
+	if stand.Runs[0] >= 0 && stand.Runs[1] >= 0 {
+		line += helpStyle.Render(fmt.Sprintf(" • %s %d, %s %d",
+			stand.Batters[0], stand.Runs[0], stand.Batters[1], stand.Runs[1]))
+	}
+	return "\n" + line + "\n"
+}
+
+// renderStanding renders the lead or trail of a multi-day match, the
+// fourth innings target and where the follow-on stands
+func (m Model) renderStanding(match models.MatchInfo) string {
+	standing, ok := stats.ComputeStanding(match)
+	if !ok || match.IsFinished() {
+		return ""
+	}
+
+	var parts []string
+	switch {
+	case standing.Target > 0:
+		parts = append(parts, fmt.Sprintf("Target %d • %s need %d", standing.Target, standing.BattingTeam, standing.Needed()))
+	case standing.Lead > 0:
+		parts = append(parts, fmt.Sprintf("%s lead by %d", standing.BattingTeam, standing.Lead))
+	case standing.Lead < 0:
+		parts = append(parts, fmt.Sprintf("%s trail by %d", standing.BattingTeam, -standing.Lead))
+	default:
+		parts = append(parts, "Scores level")
+	}
+
+	inningsPlayed := len(match.CricbuzzInfo.Miniscore.MatchScoreDetails.InningsScoreList)
+	switch standing.FollowOn {
+	case stats.FollowOnPending:
+		parts = append(parts, fmt.Sprintf("%d to avoid the follow-on", standing.FollowOnNeeded))
+	case stats.FollowOnAvoided:
+		if inningsPlayed == 2 {
+			parts = append(parts, "follow-on avoided")
+		}
+	case stats.FollowOnAvailable:
+		parts = append(parts, fmt.Sprintf("%s can enforce the follow-on", standing.OtherTeam))
+	case stats.FollowOnEnforced:
+		parts = append(parts, "follow-on enforced")
+	case stats.FollowOnNotEnforced:
+		if inningsPlayed == 3 {
+			parts = append(parts, "follow-on not enforced")
+		}
+	}
+
+	line := strings.Join(parts, " • ")
+	return lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center).Render(statusStyle.Render(line)) + "\n"
+}
+
+// renderDay renders the day and session of a multi-day match and the overs
+// bowled on the day, e.g. "Day 2 • Session 3 • 61.4 overs today", marking
+// day/night matches
+func (m Model) renderDay(match models.MatchInfo) string {
+	state, ok := stats.CurrentDay(match, m.timelines[match.CricbuzzMatchID])
+	if !ok || match.IsFinished() {
+		return ""
+	}
+
+	parts := []string{fmt.Sprintf("Day %d", state.Day)}
+	if state.DayNight {
+		parts = append(parts, "Day/night")
+	}
+	if state.Break != "" {
+		parts = append(parts, strings.ToUpper(state.Break[:1])+state.Break[1:])
+	} else {
+		parts = append(parts, fmt.Sprintf("Session %d", state.Session))
+	}
+	if state.BallsToday > 0 {
+		overs := models.BallsToOvers(state.BallsToday, models.BallsPerOver(match.CricbuzzInfo.MatchHeader.MatchFormat))
+		today := fmt.Sprintf("%s overs today", overs)
+		if state.Partial {
+			today = fmt.Sprintf("%s overs seen today", overs)
+		}
+		parts = append(parts, today)
+	}
+
+	line := strings.Join(parts, " • ")
+	return lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center).Render(helpStyle.Render(line)) + "\n"
+}
+
+// renderSummary renders the result card of a completed match: the margin,
+// the players of the match and series, the best batting and bowling of each
+// side and the key moments
+func (m Model) renderSummary(summary stats.Summary) string {
+	var content strings.Builder
+	center := lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center)
+
+	if summary.Winner != "" && summary.Margin != "" {
+		content.WriteString(center.Render(completedStyle.Render(fmt.Sprintf("%s won by %s", summary.Winner, summary.Margin))))
+		content.WriteString("\n")
+	}
+	for _, award := range []struct {
+		label   string
+		players []string
+	}{{"Player of the match ", summary.PlayersOfTheMatch}, {"Player of the series ", summary.PlayersOfTheSeries}} {
+		if len(award.players) > 0 {
+			line := helpStyle.Render(award.label) + scoreStyle.Render(strings.Join(award.players, ", "))
+			content.WriteString(center.Render(line))
+			content.WriteString("\n")
+		}
+	}
+
+	// Best batting and bowling of each side next to each other
+	columnWidth := m.layout.width / max(len(summary.Sides), 1)
+	batters := 0
+	for _, side := range summary.Sides {
+		batters = max(batters, len(side.Batters))
+	}
+	var columns []string
+	for _, side := range summary.Sides {
+		lines := []string{tableHeaderStyle.UnsetPadding().Render(side.Team)}
+		for _, bat := range side.Batters {
+			lines = append(lines, truncateString(bat.String(), columnWidth-1))
+		}
+		for range batters - len(side.Batters) + 1 {
+			lines = append(lines, "")
+		}
+		for _, bowl := range side.Bowlers {
+			lines = append(lines, truncateString(bowl.String(), columnWidth-1))
+		}
+		columns = append(columns, lipgloss.NewStyle().Width(columnWidth).Render(strings.Join(lines, "\n")))
+	}
+	if len(columns) > 0 {
+		content.WriteString("\n")
+		content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, columns...))
+		content.WriteString("\n")
+	}
+
+	if len(summary.Moments) > 0 {
+		content.WriteString("\n")
+		content.WriteString(tableHeaderStyle.UnsetPadding().Render("Key moments"))
+		for _, moment := range summary.Moments {
+			content.WriteString("\n")
+			content.WriteString(helpStyle.Render("• ") + truncateString(moment, m.layout.width-2))
+		}
+		content.WriteString("\n")
+	}
+	return content.String()
+}
@@ -0,0 +1,352 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yannlawrency/crictty/internal/models"
+	"github.com/yannlawrency/crictty/internal/stats"
+	"github.com/yannlawrency/crictty/internal/store"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// replaySpeeds are the delays between snapshots while playing, slowest first
+var replaySpeeds = []time.Duration{
+	2 * time.Second,
+	1 * time.Second,
+	500 * time.Millisecond,
+	250 * time.Millisecond,
+	100 * time.Millisecond,
+}
+
+// replayKeyMap defines the key bindings of the replay mode
+type replayKeyMap struct {
+	Prev       key.Binding
+	Next       key.Binding
+	First      key.Binding
+	Last       key.Binding
+	PrevWicket key.Binding
+	NextWicket key.Binding
+	Play       key.Binding
+	Faster     key.Binding
+	Slower     key.Binding
+}
+
+// Define key bindings for scrubbing through the timeline
+var replayKeys = replayKeyMap{
+	Prev: key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("←/h", "previous snapshot"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("right", "l"),
+		key.WithHelp("→/l", "next snapshot"),
+	),
+	First: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "first snapshot"),
+	),
+	Last: key.NewBinding(
+		key.WithKeys("G"),
+		key.WithHelp("G", "last snapshot"),
+	),
+	PrevWicket: key.NewBinding(
+		key.WithKeys("["),
+		key.WithHelp("[", "previous wicket"),
+	),
+	NextWicket: key.NewBinding(
+		key.WithKeys("]"),
+		key.WithHelp("]", "next wicket"),
+	),
+	Play: key.NewBinding(
+		key.WithKeys(" ", "p"),
+		key.WithHelp("space", "play/pause"),
+	),
+	Faster: key.NewBinding(
+		key.WithKeys("+", "="),
+		key.WithHelp("+", "faster"),
+	),
+	Slower: key.NewBinding(
+		key.WithKeys("-"),
+		key.WithHelp("-", "slower"),
+	),
+}
+
+// replayTickMsg advances playback. The generation ties it to the play
+// session that scheduled it, so ticks from an earlier session are dropped.
+type replayTickMsg struct{ generation int }
+
+// ReplayModel scrubs through the recorded snapshots of a single match
+type ReplayModel struct {
+	view       Model
+	snapshots  []store.Snapshot
+	wickets    []int
+	cursor     int
+	playing    bool
+	speed      int
+	generation int
+
+	// tracked is how many snapshots from the first are counted in the
+	// timeline and control stats of the view
+	tracked int
+
+	// lengths keeps the length of the timeline after each tracked snapshot
+	// and checkpoints the control stats after every replayCheckpoint-th
+	// one, so stepping back does not replay the match from the start
+	lengths     []int
+	checkpoints []stats.Control
+}
+
+// replayCheckpoint is how many snapshots apart the control stats are kept
+// for stepping back
+const replayCheckpoint = 50
+
+// NewReplayModel creates a replay of the given snapshots, starting at the
+// first one so the result is not given away
+func NewReplayModel(snapshots []store.Snapshot) ReplayModel {
+	r := ReplayModel{
+		snapshots: snapshots,
+		speed:     1,
+		view:      Model{layout: newLayout(0), scroll: viewport.New(0, 0), zones: newZones()},
+	}
+
+	// Remember where wickets fell, comparing each snapshot to the one before
+	for i := 1; i < len(snapshots); i++ {
+		if totalWickets(snapshots[i].Match) > totalWickets(snapshots[i-1].Match) {
+			r.wickets = append(r.wickets, i)
+		}
+	}
+
+	r.seek(0)
+	r.syncScroll()
+	return r
+}
+
+// totalWickets returns the number of wickets fallen across all innings
+func totalWickets(match models.MatchInfo) uint32 {
+	var wickets uint32
+	for _, innings := range match.CricbuzzInfo.Miniscore.MatchScoreDetails.InningsScoreList {
+		wickets += innings.Wickets
+	}
+	return wickets
+}
+
+// seek moves the cursor to snapshot i and renders it in the match view
+func (r *ReplayModel) seek(i int) {
+	if len(r.snapshots) == 0 {
+		return
+	}
+	r.cursor = max(0, min(i, len(r.snapshots)-1))
+
+	// Extend the timeline up to the cursor when moving forward, and cut it
+	// back when moving back, so nothing later leaks in
+	if r.cursor < r.tracked-1 {
+		r.rewind(r.cursor)
+	}
+	for j := r.tracked; j <= r.cursor; j++ {
+		r.trackSnapshot(j)
+	}
+
+	match := r.snapshots[r.cursor].Match
+	r.view.selectedID = match.CricbuzzMatchID
+	r.view.applyMatches([]models.MatchInfo{match})
+}
+
+// trackSnapshot counts the next snapshot in the timeline and control stats
+func (r *ReplayModel) trackSnapshot(i int) {
+	match := r.snapshots[i].Match
+	r.view.track([]models.MatchInfo{match})
+	r.lengths = append(r.lengths, len(r.view.timelines[match.CricbuzzMatchID]))
+	if i%replayCheckpoint == 0 {
+		r.checkpoints = append(r.checkpoints, r.view.controls[match.CricbuzzMatchID].Clone())
+	}
+	r.tracked = i + 1
+}
+
+// rewind takes the timeline and control stats back to how they were after
+// snapshot i, from the closest checkpoint before it
+func (r *ReplayModel) rewind(i int) {
+	matchID := r.snapshots[i].Match.CricbuzzMatchID
+	k := i / replayCheckpoint
+
+	r.view.timelines[matchID] = r.view.timelines[matchID][:r.lengths[i]]
+	control := r.checkpoints[k].Clone()
+	for _, snap := range r.snapshots[k*replayCheckpoint+1 : i+1] {
+		control = control.Add(snap.Match)
+	}
+	r.view.controls[matchID] = control
+
+	r.lengths = r.lengths[:i+1]
+	r.checkpoints = r.checkpoints[:k+1]
+	r.tracked = i + 1
+}
+
+// replayTickCmd schedules the next playback step at the current speed
+func (r ReplayModel) replayTickCmd() tea.Cmd {
+	generation := r.generation
+	return tea.Tick(replaySpeeds[r.speed], func(time.Time) tea.Msg {
+		return replayTickMsg{generation}
+	})
+}
+
+// Init initializes the replay
+func (r ReplayModel) Init() tea.Cmd {
+	return tea.EnterAltScreen
+}
+
+// Update handles scrubbing, playback and the regular scorecard navigation
+func (r ReplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	updated, cmd := r.update(msg)
+	r = updated.(ReplayModel)
+	r.syncScroll()
+	return r, cmd
+}
+
+// update handles a message for Update
+func (r ReplayModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		r.view.resize(msg.Width, msg.Height)
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return r, tea.Quit
+		case key.Matches(msg, replayKeys.Prev):
+			r.seek(r.cursor - 1)
+		case key.Matches(msg, replayKeys.Next):
+			r.seek(r.cursor + 1)
+		case key.Matches(msg, replayKeys.First):
+			r.seek(0)
+		case key.Matches(msg, replayKeys.Last):
+			r.seek(len(r.snapshots) - 1)
+		case key.Matches(msg, replayKeys.PrevWicket):
+			for i := len(r.wickets) - 1; i >= 0; i-- {
+				if r.wickets[i] < r.cursor {
+					r.seek(r.wickets[i])
+					break
+				}
+			}
+		case key.Matches(msg, replayKeys.NextWicket):
+			for _, i := range r.wickets {
+				if i > r.cursor {
+					r.seek(i)
+					break
+				}
+			}
+		case key.Matches(msg, replayKeys.Faster):
+			r.speed = min(r.speed+1, len(replaySpeeds)-1)
+		case key.Matches(msg, replayKeys.Slower):
+			r.speed = max(r.speed-1, 0)
+		case key.Matches(msg, replayKeys.Play):
+			r.playing = !r.playing
+			r.generation++
+			if r.playing {
+				if r.cursor == len(r.snapshots)-1 {
+					r.seek(0)
+				}
+				return r, r.replayTickCmd()
+			}
+		case key.Matches(msg, keys.Up), key.Matches(msg, keys.Down), key.Matches(msg, keys.Tab),
+			key.Matches(msg, keys.Chart), key.Matches(msg, scrollKeys.PageUp), key.Matches(msg, scrollKeys.PageDown),
+			key.Matches(msg, scrollKeys.Top), key.Matches(msg, scrollKeys.Bottom):
+			view, _ := r.view.update(msg)
+			r.view = view.(Model)
+		}
+
+	case tea.MouseMsg:
+		view, _ := r.view.update(msg)
+		r.view = view.(Model)
+
+	case replayTickMsg:
+		if !r.playing || msg.generation != r.generation {
+			return r, nil
+		}
+		r.seek(r.cursor + 1)
+		if r.cursor == len(r.snapshots)-1 {
+			r.playing = false
+			return r, nil
+		}
+		return r, r.replayTickCmd()
+	}
+
+	return r, nil
+}
+
+// View renders the match as it looked at the selected snapshot, recording
+// where the parts that react to the mouse are
+func (r ReplayModel) View() string {
+	return r.view.zones.scan(r.render())
+}
+
+// render renders the screen for View
+func (r ReplayModel) render() string {
+	if len(r.snapshots) == 0 {
+		return r.view.renderNotFoundMessage()
+	}
+
+	var content strings.Builder
+	snap := r.snapshots[r.cursor]
+
+	content.WriteString(r.view.renderMatchArea("", snap.Match))
+	content.WriteString("\n")
+	content.WriteString(r.renderBottom())
+
+	return r.view.centerHorizontally(content.String())
+}
+
+// renderBottom renders the timeline and help below the scorecard
+func (r ReplayModel) renderBottom() string {
+	return "\n" + r.renderTimeline() + "\n\n" +
+		helpStyle.Render("q: quit • ←→: step • g/G: first/last • [ ]: wickets • space: play/pause • +-: speed • ↑↓: innings • b: batting/bowling • c: charts • pgup/pgdn: scroll")
+}
+
+// syncScroll fits the scorecard viewport of the replay between the match
+// above it and the timeline below it
+func (r *ReplayModel) syncScroll() {
+	if len(r.snapshots) == 0 {
+		return
+	}
+	match := r.snapshots[r.cursor].Match
+	r.view.fitScroll(match, r.view.rowsAbove("", match)+r.view.rows(r.renderBottom()))
+}
+
+// renderTimeline renders the playback state and the position in the timeline
+func (r ReplayModel) renderTimeline() string {
+	state := "❚❚"
+	if r.playing {
+		state = "▶"
+	}
+
+	// Mark the cursor and the wickets so far on a bar as wide as the
+	// content, later wickets stay hidden to avoid spoilers
+	bar := []rune(strings.Repeat("─", r.view.layout.width))
+	for _, i := range r.wickets {
+		if i <= r.cursor {
+			bar[r.barPosition(i, len(bar))] = 'W'
+		}
+	}
+	bar[r.barPosition(r.cursor, len(bar))] = '●'
+
+	snap := r.snapshots[r.cursor]
+	info := fmt.Sprintf("%s %s  %d/%d  %.1fx",
+		state,
+		snap.Time.Local().Format("Mon 02 Jan 15:04:05"),
+		r.cursor+1,
+		len(r.snapshots),
+		float64(time.Second)/float64(replaySpeeds[r.speed]))
+
+	return helpStyle.Render(string(bar)) + "\n" + scoreStyle.Render(info)
+}
+
+// barPosition maps a snapshot index to a cell of a bar of the given width
+func (r ReplayModel) barPosition(i, width int) int {
+	if len(r.snapshots) <= 1 {
+		return 0
+	}
+	return i * (width - 1) / (len(r.snapshots) - 1)
+}
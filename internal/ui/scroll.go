@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yannlawrency/crictty/internal/models"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// minScrollHeight is the fewest scorecard rows shown, however little room
+// the rest of the view leaves
+const minScrollHeight = 5
+
+// scrollKeys are the key bindings of the scorecard viewport
+var scrollKeys = struct {
+	PageUp   key.Binding
+	PageDown key.Binding
+	Top      key.Binding
+	Bottom   key.Binding
+}{
+	PageUp: key.NewBinding(
+		key.WithKeys("pgup"),
+		key.WithHelp("pgup", "scroll up"),
+	),
+	PageDown: key.NewBinding(
+		key.WithKeys("pgdown"),
+		key.WithHelp("pgdn", "scroll down"),
+	),
+	Top: key.NewBinding(
+		key.WithKeys("home"),
+		key.WithHelp("home", "scroll to top"),
+	),
+	Bottom: key.NewBinding(
+		key.WithKeys("end"),
+		key.WithHelp("end", "scroll to bottom"),
+	),
+}
+
+// scrollTarget is what the scorecard viewport shows, it goes back to the
+// top when this changes
+type scrollTarget struct {
+	matchID uint32
+	innings int
+	chart   chartView
+	bowling bool
+}
+
+// syncScroll fits the scorecard viewport between the match above or beside
+// it and the status lines below it. It runs after every update so the scroll keys work
+// on the content and height on screen.
+func (m *Model) syncScroll() {
+	if len(m.matches) == 0 {
+		return
+	}
+	match := m.matches[m.selectedIndex()]
+	m.fitScroll(match, m.rowsAbove(m.renderMatchTabs(), match)+m.rows(m.renderViewBottom(match)))
+}
+
+// rows returns how many terminal rows content takes once lines too long
+// for the terminal wrap
+func (m Model) rows(content string) int {
+	if m.width > 0 {
+		content = lipgloss.NewStyle().Width(m.width).Render(content)
+	}
+	return lipgloss.Height(content)
+}
+
+// fitScroll fills the scorecard viewport for a match, with the given number
+// of rows taken by the rest of the view
+func (m *Model) fitScroll(match models.MatchInfo, reserved int) {
+	// The view puts the line breaks around the viewport itself
+	content := strings.TrimPrefix(strings.TrimSuffix(m.renderScorecardArea(match), "\n"), "\n")
+	m.scroll.Width = m.layout.scorecardWidth
+	m.scroll.SetContent(content)
+
+	// Show everything when it fits or the terminal size is not known,
+	// leaving a row for the top margin and one for the scroll position
+	lines := m.scroll.TotalLineCount()
+	m.scroll.Height = lines
+	if m.height > 0 && lines > m.height-reserved-1 {
+		m.scroll.Height = max(m.height-reserved-2, minScrollHeight)
+	}
+
+	target := scrollTarget{match.CricbuzzMatchID, m.currentInnings, m.chart, m.showBowling}
+	if target != m.scrolled {
+		m.scrolled = target
+		m.scroll.GotoTop()
+	}
+	m.scroll.SetYOffset(m.scroll.YOffset)
+}
+
+// renderScroll renders the visible part of the scorecard, with the scroll
+// position under it when it does not all fit
+func (m Model) renderScroll() string {
+	view := m.scroll.View()
+	total := m.scroll.TotalLineCount()
+	if total <= m.scroll.Height {
+		return view
+	}
+
+	first := m.scroll.YOffset + 1
+	last := m.scroll.YOffset + m.scroll.VisibleLineCount()
+	position := fmt.Sprintf("↕ %d-%d of %d • %.0f%%", first, last, total, m.scroll.ScrollPercent()*100)
+	return view + "\n" + lipgloss.NewStyle().Width(m.layout.scorecardWidth).Align(lipgloss.Right).
+		Render(helpStyle.Render(position))
+}
+
+// scrollWheel scrolls the scorecard for a mouse wheel event
+func (m *Model) scrollWheel(msg tea.MouseMsg) {
+	if msg.Action != tea.MouseActionPress {
+		return
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.scroll.ScrollUp(m.scroll.MouseWheelDelta)
+	case tea.MouseButtonWheelDown:
+		m.scroll.ScrollDown(m.scroll.MouseWheelDelta)
+	}
+}
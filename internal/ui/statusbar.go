@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yannlawrency/crictty/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// clockMsg redraws the status bar every second
+type clockMsg time.Time
+
+// clockCmd ticks once a second to keep the countdown and data age current
+func clockCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return clockMsg(t)
+	})
+}
+
+// renderStatusBar renders when the selected match was last updated, when the
+// next refresh is due, the connection state and the upstream latency.
+// Finished matches are not polled, so they are never stale.
+func (m Model) renderStatusBar(match models.MatchInfo) string {
+	var parts []string
+	finished := match.IsFinished()
+
+	// Age of the data, with a warning once it is stale
+	if lastUpdated := match.LastUpdated; !lastUpdated.IsZero() {
+		age := time.Since(lastUpdated)
+		updated := fmt.Sprintf("Updated %s (%s ago)",
+			lastUpdated.Local().Format("15:04:05"),
+			formatAge(age))
+		if !finished && m.staleAfter > 0 && age > m.staleAfter {
+			parts = append(parts, warningStyle.Render("⚠ "+updated))
+		} else {
+			parts = append(parts, helpStyle.Render(updated))
+		}
+	}
+
+	// Countdown to the next refresh
+	switch {
+	case m.refreshing:
+		parts = append(parts, helpStyle.Render("Refreshing..."))
+	case !finished && !m.nextRefresh.IsZero():
+		wait := max(time.Until(m.nextRefresh), 0).Round(time.Second)
+		parts = append(parts, helpStyle.Render(fmt.Sprintf("Next in %s", formatAge(wait))))
+	}
+
+	// Connection state of the last refresh
+	if m.refreshErr != nil {
+		parts = append(parts, errorStyle.Render("● Offline"))
+	} else {
+		parts = append(parts, onlineStyle.Render("● Online"))
+	}
+
+	// Upstream latency
+	if latency := m.app.Latency(); latency > 0 {
+		parts = append(parts, helpStyle.Render(fmt.Sprintf("%dms", latency.Milliseconds())))
+	}
+
+	return strings.Join(parts, helpStyle.Render(" • "))
+}
+
+// formatAge formats a duration in the largest whole unit that fits, like 5s, 3m or 2h
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+}
@@ -31,6 +31,26 @@ var (
 			Padding(0, 1).
 			MarginTop(1)
 
+	hoverRowStyle = rowStyle.
+			Background(lipgloss.Color("236"))
+
 	helpStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("8"))
+
+	completedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("0")).
+			Background(lipgloss.Color("10")).
+			Bold(true).
+			Padding(0, 1)
+
+	onlineStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("10"))
+
+	warningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("11")).
+			Bold(true)
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9")).
+			Bold(true)
 )
@@ -2,27 +2,36 @@ package ui
 
 import (
 	"fmt"
+	"os"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/yannlawrency/crictty/internal/app"
+	"github.com/yannlawrency/crictty/internal/cricbuzz"
 	"github.com/yannlawrency/crictty/internal/models"
+	"github.com/yannlawrency/crictty/internal/stats"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
-const mainWidth = 65 // Width of the main content area, adjust as needed
-
 // keyMap defines the key bindings for the application
 type keyMap struct {
-	Up    key.Binding
-	Down  key.Binding
-	Left  key.Binding
-	Right key.Binding
-	Tab   key.Binding
-	Quit  key.Binding
+	Up     key.Binding
+	Down   key.Binding
+	Left   key.Binding
+	Right  key.Binding
+	Tab    key.Binding
+	Chart  key.Binding
+	Export key.Binding
+	Add    key.Binding
+	Remove key.Binding
+	Quit   key.Binding
 }
 
 // Define key bindings for navigation and actions
@@ -47,145 +56,684 @@ var keys = keyMap{
 		key.WithKeys("b"),
 		key.WithHelp("b", "switch batting/bowling"),
 	),
+	Chart: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "switch scorecard/charts"),
+	),
+	Export: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "export result card"),
+	),
+	Add: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "add match"),
+	),
+	Remove: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "remove match"),
+	),
 	Quit: key.NewBinding(
 		key.WithKeys("q", "ctrl+c"),
 		key.WithHelp("q", "quit"),
 	),
 }
 
-type tickMsg time.Time
+// maxChoicesShown is the most matches listed at once when picking one of
+// several
+const maxChoicesShown = 9
+
+// inputKeys are the key bindings of the add match prompt
+var inputKeys = struct {
+	Confirm key.Binding
+	Cancel  key.Binding
+}{
+	Confirm: key.NewBinding(key.WithKeys("enter")),
+	Cancel:  key.NewBinding(key.WithKeys("esc", "ctrl+c")),
+}
+
+// tickMsg triggers a refresh. The generation ties it to the refresh that
+// scheduled it, so only a single tick loop is ever running.
+type tickMsg struct{ generation int }
+
+// matchesMsg carries a fresh snapshot of the matches from a refresh
+type matchesMsg []models.MatchInfo
+
+// refreshErrMsg reports a failed refresh
+type refreshErrMsg struct{ err error }
+
+// exportedMsg reports where the result card of a match was saved
+type exportedMsg struct {
+	path string
+	err  error
+}
+
+// resolvedMsg carries the matches found for the add match prompt
+type resolvedMsg struct {
+	fixtures []cricbuzz.Fixture
+	err      error
+}
 
 // Model represents the state of the application
 type Model struct {
 	app            *app.App
-	selectedMatch  int
+	matches        []models.MatchInfo
+	selectedID     uint32
 	currentInnings int
 	showBowling    bool
+	chart          chartView
 	tickRate       int
+	tickGeneration int
+	nextRefresh    time.Time
+	refreshing     bool
+	refreshQueued  bool
+	refreshErr     error
+	staleAfter     time.Duration
+	input          textinput.Model
+	inputActive    bool
+	choices        []cricbuzz.Fixture
+	choice         int
+	notice         string
+	adding         uint32
 	width          int
 	height         int
+	layout         layout
+
+	// scroll is the viewport of the scorecard, scrolled is what it shows
+	scroll   viewport.Model
+	scrolled scrollTarget
+
+	// zones keeps where the parts of the last view that react to the mouse
+	// are, hovered is the one under the mouse
+	zones   *zones
+	hovered zone
+
+	// timelines keeps the progression of every match seen, by match ID
+	timelines map[uint32]stats.Timeline
+
+	// controls keeps the dot balls and minutes of the players of every match
+	// seen, by match ID
+	controls map[uint32]stats.Control
+
+	// Startup loading state, see loading.go
+	loading         bool
+	targets         []cricbuzz.Fixture
+	pending         int
+	loadErr         error
+	backoff         time.Duration
+	retryAt         time.Time
+	retryGeneration int
+	spinner         spinner.Model
 }
 
-// NewModel creates a new Model instance with the given app and tick rate
-func NewModel(app *app.App, tickRate int) Model {
+// NewModel creates a new Model instance with the given app and tick rate.
+// Data older than staleAfter is flagged in the status bar.
+func NewModel(app *app.App, tickRate int, staleAfter time.Duration) Model {
+	input := textinput.New()
+	input.Prompt = "Match ID, URL or teams: "
+	input.CharLimit = 200
+	input.Width = 40
+
 	return Model{
 		app:            app,
-		selectedMatch:  0,
 		currentInnings: 0,
 		showBowling:    false,
 		tickRate:       tickRate,
+		staleAfter:     staleAfter,
+		input:          input,
+		loading:        true,
+		timelines:      make(map[uint32]stats.Timeline),
+		controls:       make(map[uint32]stats.Control),
+		spinner:        spinner.New(spinner.WithSpinner(spinner.MiniDot)),
+		layout:         newLayout(0),
+		scroll:         viewport.New(0, 0),
+		zones:          newZones(),
 	}
 }
 
-// Init initializes the model, setting up the initial state and starting the tick command
+// Init initializes the model, starting the spinner and loading the matches
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		tea.EnterAltScreen,
-		tickCmd(m.tickRate),
+		m.spinner.Tick,
+		clockCmd(),
+		loadTargetsCmd(m.app),
 	)
 }
 
-// tickCmd returns a command that ticks at the specified rate and updates matches
-func tickCmd(tickRate int) tea.Cmd {
-	return tea.Tick(time.Duration(tickRate)*time.Millisecond, func(t time.Time) tea.Msg {
-		return tickMsg(t)
+// tickCmd returns a command that ticks at the specified rate to trigger a refresh
+func tickCmd(tickRate, generation int) tea.Cmd {
+	return tea.Tick(time.Duration(tickRate)*time.Millisecond, func(time.Time) tea.Msg {
+		return tickMsg{generation}
 	})
 }
 
-// Update handles incoming messages and updates the model state accordingly
+// refreshCmd fetches a new snapshot in the background. The current snapshot
+// is passed by value and only read, the result is applied in Update.
+func refreshCmd(a *app.App, current []models.MatchInfo) tea.Cmd {
+	return func() tea.Msg {
+		matches, err := a.Refresh(current)
+		if err != nil {
+			return refreshErrMsg{err}
+		}
+		return matchesMsg(matches)
+	}
+}
+
+// requestRefresh starts a refresh, or queues one if a refresh is already running
+func (m *Model) requestRefresh() tea.Cmd {
+	if m.refreshing {
+		m.refreshQueued = true
+		return nil
+	}
+	m.refreshing = true
+	return refreshCmd(m.app, m.matches)
+}
+
+// refreshDone runs a queued refresh or schedules the next tick
+func (m *Model) refreshDone() tea.Cmd {
+	m.refreshing = false
+	if m.refreshQueued {
+		m.refreshQueued = false
+		return m.requestRefresh()
+	}
+	return m.scheduleTick()
+}
+
+// scheduleTick starts a new tick loop, replacing any running one
+func (m *Model) scheduleTick() tea.Cmd {
+	m.tickGeneration++
+	m.nextRefresh = time.Now().Add(time.Duration(m.tickRate) * time.Millisecond)
+	return tickCmd(m.tickRate, m.tickGeneration)
+}
+
+// selectedIndex returns the index of the selected match in the current snapshot
+func (m Model) selectedIndex() int {
+	for i, match := range m.matches {
+		if match.CricbuzzMatchID == m.selectedID {
+			return i
+		}
+	}
+	return 0
+}
+
+// selectMatch selects the match at index i and resets the scorecard navigation
+func (m *Model) selectMatch(i int) {
+	if i < 0 || i >= len(m.matches) {
+		return
+	}
+	m.selectedID = m.matches[i].CricbuzzMatchID
+	m.currentInnings = 0
+	m.showBowling = false
+	m.fitChart(m.matches[i])
+}
+
+// applyMatches replaces the current snapshot, keeping the selection on the
+// same match ID where possible
+func (m *Model) applyMatches(matches []models.MatchInfo) {
+	prevIndex := m.selectedIndex()
+	m.matches = matches
+	m.track(matches)
+	if len(matches) == 0 {
+		return
+	}
+
+	for _, match := range matches {
+		if match.CricbuzzMatchID == m.selectedID {
+			if m.currentInnings >= len(match.Scorecard) {
+				m.currentInnings = max(len(match.Scorecard)-1, 0)
+			}
+			m.fitChart(match)
+			return
+		}
+	}
+
+	// The selected match is gone, fall back to its neighbour
+	m.selectMatch(min(prevIndex, len(matches)-1))
+}
+
+// track adds the snapshot of every match to its timeline and control stats
+func (m *Model) track(matches []models.MatchInfo) {
+	if m.timelines == nil {
+		m.timelines = make(map[uint32]stats.Timeline)
+	}
+	if m.controls == nil {
+		m.controls = make(map[uint32]stats.Control)
+	}
+	for _, match := range matches {
+		m.timelines[match.CricbuzzMatchID] = m.timelines[match.CricbuzzMatchID].Add(match)
+		m.controls[match.CricbuzzMatchID] = m.controls[match.CricbuzzMatchID].Add(match)
+	}
+}
+
+// seedHistory starts the timeline and control stats of a match from its
+// recorded snapshots
+func (m *Model) seedHistory(matchID uint32, history []models.MatchInfo) {
+	if _, ok := m.timelines[matchID]; ok {
+		return
+	}
+	m.track(history)
+}
+
+// reseedHistory rebuilds the timeline and control stats of a match from its
+// recorded snapshots, which include the ones seen since it showed up, then
+// its current snapshot
+func (m *Model) reseedHistory(matchID uint32, history []models.MatchInfo) {
+	if len(history) == 0 {
+		return
+	}
+	delete(m.timelines, matchID)
+	delete(m.controls, matchID)
+	m.track(history)
+	for _, match := range m.matches {
+		if match.CricbuzzMatchID == matchID {
+			m.track([]models.MatchInfo{match})
+		}
+	}
+}
+
+// removeSelected stops watching the selected match and drops it from the snapshot
+func (m *Model) removeSelected() {
+	if len(m.matches) == 0 {
+		return
+	}
+	match := m.matches[m.selectedIndex()]
+	m.app.Unwatch(match.CricbuzzMatchID)
+	m.notice = fmt.Sprintf("Removed %s", match.MatchShortName)
+
+	m.applyMatches(slices.DeleteFunc(slices.Clone(m.matches), func(other models.MatchInfo) bool {
+		return other.CricbuzzMatchID == match.CricbuzzMatchID
+	}))
+}
+
+// updateInput handles key presses while the add match prompt is open
+func (m Model) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, inputKeys.Cancel):
+		m.inputActive = false
+		m.input.Blur()
+		return m, nil
+
+	case key.Matches(msg, inputKeys.Confirm):
+		query := strings.TrimSpace(m.input.Value())
+		if query == "" {
+			return m, nil
+		}
+		m.inputActive = false
+		m.input.Blur()
+		m.notice = fmt.Sprintf("Looking up %s...", query)
+		return m, resolveCmd(m.app, query)
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// updateChoices handles key presses while picking one of several matches,
+// moving through the list with the arrows and picking with enter
+func (m Model) updateChoices(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, inputKeys.Cancel):
+		m.choices = nil
+		m.notice = ""
+	case key.Matches(msg, keys.Up):
+		m.choice = max(m.choice-1, 0)
+	case key.Matches(msg, keys.Down):
+		m.choice = min(m.choice+1, len(m.choices)-1)
+	case key.Matches(msg, inputKeys.Confirm):
+		fixture := m.choices[m.choice]
+		m.choices = nil
+		return m, m.watchMatch(fixture.MatchID)
+	}
+	return m, nil
+}
+
+// resolveCmd resolves a match ID, Cricbuzz URL or query in the background
+func resolveCmd(a *app.App, query string) tea.Cmd {
+	return func() tea.Msg {
+		fixtures, err := a.Resolve(query)
+		return resolvedMsg{fixtures: fixtures, err: err}
+	}
+}
+
+// exportCmd saves the result card of a finished match as a text file in the
+// working directory
+func exportCmd(match models.MatchInfo) tea.Cmd {
+	return func() tea.Msg {
+		summary, ok := stats.ComputeSummary(match)
+		if !ok {
+			return exportedMsg{err: fmt.Errorf("%s has not finished yet", match.MatchShortName)}
+		}
+		path := fmt.Sprintf("crictty-%d-summary.txt", match.CricbuzzMatchID)
+		if err := os.WriteFile(path, []byte(summary.Text()), 0o644); err != nil {
+			return exportedMsg{err: fmt.Errorf("error saving result card: %v", err)}
+		}
+		return exportedMsg{path: path}
+	}
+}
+
+// watchMatch adds a match to the watch list, selects it and loads it
+func (m *Model) watchMatch(matchID uint32) tea.Cmd {
+	m.app.Watch(matchID)
+	m.selectedID = matchID
+	m.currentInnings = 0
+	m.showBowling = false
+	m.notice = fmt.Sprintf("Added match %d, loading...", matchID)
+	m.adding = matchID
+	return m.requestRefresh()
+}
+
+// checkAdded reports whether the match added last made it into the snapshot.
+// A match that could not be loaded is dropped from the watch list instead of
+// being fetched again on every tick.
+func (m *Model) checkAdded() {
+	// A refresh queued behind the one that finished is the first to include
+	// the added match
+	if m.adding == 0 || m.refreshQueued {
+		return
+	}
+	matchID := m.adding
+	m.adding = 0
+
+	if slices.ContainsFunc(m.matches, func(match models.MatchInfo) bool {
+		return match.CricbuzzMatchID == matchID
+	}) {
+		if strings.HasPrefix(m.notice, "Added match") {
+			m.notice = ""
+		}
+		return
+	}
+	m.app.Unwatch(matchID)
+	m.notice = fmt.Sprintf("Could not load match %d, removed it", matchID)
+}
+
+// Update handles incoming messages and updates the model state accordingly,
+// then fits the scorecard viewport to the new state
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	var cmds []tea.Cmd
+	updated, cmd := m.update(msg)
+	m = updated.(Model)
+	m.syncScroll()
+	return m, cmd
+}
 
-	// Handle window size changes
+// update handles a message for Update
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+
+	// Handle window size changes
 	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
+		m.resize(msg.Width, msg.Height)
 
 	// Handle key messages for navigation and actions
 	case tea.KeyMsg:
+		if m.inputActive {
+			return m.updateInput(msg)
+		}
+		if len(m.choices) > 0 {
+			return m.updateChoices(msg)
+		}
+
+		m.notice = ""
 		switch {
 		case key.Matches(msg, keys.Quit):
 			return m, tea.Quit
+		case key.Matches(msg, retryKey) && m.loading && m.loadErr != nil:
+			return m, m.retryNow()
 		case key.Matches(msg, keys.Left):
-			if m.selectedMatch > 0 {
-				m.selectedMatch--
-				m.currentInnings = 0
-				m.showBowling = false
-			}
+			m.selectMatch(m.selectedIndex() - 1)
 		case key.Matches(msg, keys.Right):
-			if m.selectedMatch < len(m.app.Matches)-1 {
-				m.selectedMatch++
-				m.currentInnings = 0
-				m.showBowling = false
-			}
+			m.selectMatch(m.selectedIndex() + 1)
 		case key.Matches(msg, keys.Up):
 			if m.currentInnings > 0 {
 				m.currentInnings--
 			}
 		case key.Matches(msg, keys.Down):
-			if m.selectedMatch < len(m.app.Matches) {
-				match := m.app.Matches[m.selectedMatch]
+			if len(m.matches) > 0 {
+				match := m.matches[m.selectedIndex()]
 				if m.currentInnings < len(match.Scorecard)-1 {
 					m.currentInnings++
 				}
 			}
 		case key.Matches(msg, keys.Tab):
 			m.showBowling = !m.showBowling
+		case key.Matches(msg, keys.Chart):
+			if len(m.matches) > 0 {
+				m.chart = m.nextChart(m.matches[m.selectedIndex()])
+			}
+		case key.Matches(msg, scrollKeys.PageUp):
+			m.scroll.PageUp()
+		case key.Matches(msg, scrollKeys.PageDown):
+			m.scroll.PageDown()
+		case key.Matches(msg, scrollKeys.Top):
+			m.scroll.GotoTop()
+		case key.Matches(msg, scrollKeys.Bottom):
+			m.scroll.GotoBottom()
+		case key.Matches(msg, keys.Export):
+			if len(m.matches) > 0 {
+				return m, exportCmd(m.matches[m.selectedIndex()])
+			}
+		case key.Matches(msg, keys.Add):
+			m.inputActive = true
+			m.input.SetValue("")
+			return m, m.input.Focus()
+		case key.Matches(msg, keys.Remove):
+			m.removeSelected()
 		}
 
-	// Handle tick messages to update matches
+	// Clicks on tabs, hovering over scorecard rows and the mouse wheel
+	case tea.MouseMsg:
+		m.updateMouse(msg)
+
+	// Handle tick messages by fetching a new snapshot
 	case tickMsg:
-		cmds = append(cmds, tea.Cmd(func() tea.Msg {
-			if err := m.app.UpdateMatches(); err != nil {
-				return err
-			}
-			return nil
-		}))
+		if msg.generation != m.tickGeneration {
+			return m, nil
+		}
+		return m, m.requestRefresh()
+
+	// Fill in the matches as they load on startup
+	case targetsMsg:
+		return m, m.handleTargets(msg)
+	case matchLoadedMsg:
+		return m, m.handleMatchLoaded(msg)
+	case retryMsg:
+		if msg.generation != m.retryGeneration || !m.loading {
+			return m, nil
+		}
+		return m, m.startLoading()
+
+	// Keep the spinner going only while loading
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	// Redraw the status bar
+	case clockMsg:
+		return m, clockCmd()
+
+	// Apply a fresh snapshot and schedule the next tick
+	case matchesMsg:
+		m.loading = false
+		m.refreshErr = nil
+		matches := slices.DeleteFunc([]models.MatchInfo(msg), func(match models.MatchInfo) bool {
+			return !m.app.Watching(match.CricbuzzMatchID)
+		})
+		load := m.loadNewHistories(matches)
+		m.applyMatches(matches)
+		m.checkAdded()
+		return m, tea.Batch(m.refreshDone(), load)
+
+	// Matches added or gone live since startup pick up their stored history
+	case historyLoadedMsg:
+		m.reseedHistory(msg.matchID, msg.history)
+
+	// Keep the current snapshot on failure and try again on the next tick
+	case refreshErrMsg:
+		m.refreshErr = msg.err
+		m.checkAdded()
+		return m, m.refreshDone()
+
+	// Watch the match found by the add match prompt, or ask which one
+	case resolvedMsg:
+		switch {
+		case msg.err != nil:
+			m.notice = msg.err.Error()
+		case len(msg.fixtures) == 1:
+			return m, m.watchMatch(msg.fixtures[0].MatchID)
+		default:
+			m.choices = msg.fixtures
+			m.choice = 0
+			m.notice = ""
+		}
+
+	// Tell where the result card went
+	case exportedMsg:
+		if msg.err != nil {
+			m.notice = msg.err.Error()
+		} else {
+			m.notice = fmt.Sprintf("Saved result card to %s", msg.path)
+		}
 	}
 
-	// Always schedule the next tick unless quitting
-	cmds = append(cmds, tickCmd(m.tickRate))
-	return m, tea.Batch(cmds...)
+	return m, nil
 }
 
-// View renders the current state of the model as a string
+// View renders the current state of the model as a string, recording where
+// the parts that react to the mouse are
 func (m Model) View() string {
+	return m.zones.scan(m.view())
+}
+
+// view renders the screen for View
+func (m Model) view() string {
+	// Until the first match arrives show the loading screen
+	if len(m.matches) == 0 && m.loading {
+		return m.renderLoading()
+	}
+
 	// If no matches are available show not found message
-	if len(m.app.Matches) == 0 {
+	if len(m.matches) == 0 {
 		return m.renderNotFoundMessage()
 	}
 
+	match := m.matches[m.selectedIndex()]
+	return m.centerHorizontally(m.renderMatchArea(m.renderMatchTabs(), match) + "\n" + m.renderViewBottom(match))
+}
+
+// renderMatchTabs renders the tabs of the matches watched, when there are
+// several
+func (m Model) renderMatchTabs() string {
 	var content strings.Builder
 
-	// Match tabs
-	if len(m.app.Matches) > 1 {
+	selected := m.selectedIndex()
+	if len(m.matches) > 1 {
 		var tabs []string
-		for i, name := range m.app.GetMatchNames() {
+		for i, name := range app.MatchNames(m.matches) {
 			style := tabStyle
-			if i == m.selectedMatch {
+			if i == selected {
 				style = activeTabStyle
 			}
-			tabs = append(tabs, style.Render(name))
+			tabs = append(tabs, zone{zoneMatchTab, i}.mark(style.Render(name)))
 		}
 		content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, tabs...))
 		content.WriteString("\n")
 	}
+	return content.String()
+}
 
-	// Current match info
-	if m.selectedMatch < len(m.app.Matches) {
-		match := m.app.Matches[m.selectedMatch]
-		content.WriteString(m.renderMatchInfo(match))
-		var match_id = fmt.Sprintf("Match id : %d", match.CricbuzzMatchID)
-		content.WriteString(helpStyle.Render(match_id))
-		content.WriteString("\n")
+// renderMatchArea renders the lines on top, then the match above the
+// scorecard viewport, or in its own column beside it when the layout is wide
+func (m Model) renderMatchArea(top string, match models.MatchInfo) string {
+	head := m.renderMatchHead(match)
+	if !m.layout.wide {
+		return top + head + "\n" + m.renderScroll()
 	}
+	panel := lipgloss.NewStyle().Width(m.layout.width + cardGap).Render(head)
+	return top + lipgloss.JoinHorizontal(lipgloss.Top, panel, m.renderScroll())
+}
+
+// rowsAbove returns how many rows the lines on top and the match take above
+// the scorecard viewport
+func (m Model) rowsAbove(top string, match models.MatchInfo) int {
+	if !m.layout.wide {
+		return m.rows(top + m.renderMatchHead(match))
+	}
+	if top == "" {
+		return 0
+	}
+	return m.rows(strings.TrimSuffix(top, "\n"))
+}
+
+// renderViewBottom renders the status lines, prompt and help below the
+// scorecard
+func (m Model) renderViewBottom(match models.MatchInfo) string {
+	var content strings.Builder
+
+	var match_id = fmt.Sprintf("Match id : %d", match.CricbuzzMatchID)
+	content.WriteString(helpStyle.Render(match_id))
+	content.WriteString("\n")
+	content.WriteString(m.renderStatusBar(match))
+	content.WriteString("\n")
+
+	// Matches still loading, add match prompt or the last notice
+	content.WriteString(m.renderLoadingMore())
+	content.WriteString(m.renderPrompt())
 
 	// Help
 	content.WriteString("\n")
-	content.WriteString(helpStyle.Render("q: quit • ←→: matches • ↑↓: innings • b: batting/bowling"))
+	content.WriteString(helpStyle.Render("q: quit • ←→: matches • ↑↓: innings • b: batting/bowling • c: charts • pgup/pgdn: scroll • e: export result • a/x: add/remove match"))
+	return content.String()
+}
+
+// renderPrompt renders the add match prompt, the matches to pick from and the last notice
+func (m Model) renderPrompt() string {
+	var content strings.Builder
+
+	if m.inputActive {
+		content.WriteString("\n")
+		content.WriteString(m.input.View())
+		content.WriteString("\n")
+	}
 
-	return m.centerHorizontally(content.String())
+	if len(m.choices) > 0 {
+		content.WriteString("\n")
+		content.WriteString(scoreStyle.Render("Several matches found, pick one:"))
+		content.WriteString("\n")
+
+		// Show a window of the list around the selected match
+		first := min(max(m.choice-maxChoicesShown/2, 0), max(len(m.choices)-maxChoicesShown, 0))
+		last := min(first+maxChoicesShown, len(m.choices))
+		if first > 0 {
+			content.WriteString(helpStyle.Render(fmt.Sprintf("  ↑ %d more", first)))
+			content.WriteString("\n")
+		}
+		for i := first; i < last; i++ {
+			line := fmt.Sprintf("  %s - %s", m.choices[i].ShortName, m.choices[i].State)
+			if i == m.choice {
+				line = scoreStyle.Render("> " + line[2:])
+			}
+			content.WriteString(line)
+			content.WriteString("\n")
+		}
+		if last < len(m.choices) {
+			content.WriteString(helpStyle.Render(fmt.Sprintf("  ↓ %d more", len(m.choices)-last)))
+			content.WriteString("\n")
+		}
+		content.WriteString(helpStyle.Render("↑↓: move • enter: pick • esc: cancel"))
+		content.WriteString("\n")
+	}
+
+	if m.notice != "" {
+		content.WriteString("\n")
+		content.WriteString(statusStyle.Render(m.notice))
+		content.WriteString("\n")
+	}
+
+	return content.String()
 }
 
 // centerHorizontally centers the content horizontally in the terminal
@@ -205,95 +753,130 @@ func (m Model) renderNotFoundMessage() string {
 		"• No matches currently being played\n" +
 		"• Your internet connection\n\n" +
 		"Please try again in a few moments.\n\n" +
-		"Use the --match-id flag with a valid match ID from Cricbuzz to view a specific match.\n\n"
+		"Press 'a' or use the --match-id flag with a valid match ID from Cricbuzz to view a specific match.\n\n"
 
-	notFoundMessage += helpStyle.Render("Press 'q' to quit\n")
+	notFoundMessage += m.renderPrompt()
+	notFoundMessage += helpStyle.Render("\nPress 'q' to quit\n")
 	return m.styleNotFoundMessage(notFoundMessage)
 }
 
 // styleNotFoundMessage styles the "no matches" message
 func (m Model) styleNotFoundMessage(content string) string {
 	return tabStyle.
-		Width(mainWidth).
+		Width(m.layout.width).
 		MarginTop((m.height-20)/2).
-		MarginLeft((m.width-mainWidth)/2).
+		MarginLeft((m.width-m.layout.width)/2).
 		Padding(0, 4).
 		Render(content)
 }
 
-// renderMatchInfo renders the match information including teams, scores, and current innings
-func (m Model) renderMatchInfo(match models.MatchInfo) string {
+// renderMatchHead renders the match information including teams, scores,
+// and current innings, everything above the scorecard
+func (m Model) renderMatchHead(match models.MatchInfo) string {
 	var content strings.Builder
 
 	// Match header
-	if len(m.app.Matches) <= 1 {
+	if len(m.matches) <= 1 {
 		header := fmt.Sprintf("%s vs %s - %s",
 			match.CricbuzzInfo.MatchHeader.Team1.ShortName,
 			match.CricbuzzInfo.MatchHeader.Team2.ShortName,
 			match.CricbuzzInfo.MatchHeader.MatchFormat)
-		headerStyled := activeTabStyle.Align(lipgloss.Center)
-		content.WriteString(headerStyled.Render(header))
+		content.WriteString(lipgloss.PlaceHorizontal(m.layout.width, lipgloss.Center, activeTabStyle.Render(header)))
+		content.WriteString("\n")
+	}
+
+	// Completed banner with the result
+	if match.IsFinished() {
+		content.WriteString("\n")
+		content.WriteString(m.renderCompleted(match))
 		content.WriteString("\n")
 	}
 
 	// Team scores
 	content.WriteString("\n")
 	content.WriteString(m.renderTeamScores(match.CricbuzzInfo.Miniscore.MatchScoreDetails))
-	content.WriteString("\n\n")
-
-	// Current innings info
-	miniscore := match.CricbuzzInfo.Miniscore
-	content.WriteString(m.renderCurrentInnings(miniscore))
 	content.WriteString("\n")
 
-	// Scorecard with batting/bowling tabs
-	if len(match.Scorecard) > 0 && m.currentInnings < len(match.Scorecard) {
-		content.WriteString(m.renderCurrentInningsScorecard(match.Scorecard[m.currentInnings], m.currentInnings))
+	// Result card once the match is over, the live panels until then
+	if summary, ok := stats.ComputeSummary(match); ok {
+		content.WriteString(m.renderSummary(summary))
+	} else {
+		content.WriteString(m.renderWinProbability(match))
+		content.WriteString(m.renderStanding(match))
+		content.WriteString(m.renderDay(match))
+		content.WriteString("\n")
+
+		// Current innings info
+		miniscore := match.CricbuzzInfo.Miniscore
+		content.WriteString(m.renderCurrentInnings(miniscore, m.controls[match.CricbuzzMatchID]))
+		content.WriteString("\n")
+		content.WriteString(m.renderPartnership(match))
+		content.WriteString(m.renderMilestones(match))
+
+		// Chase equation, DLS par score or projected totals
+		content.WriteString(m.renderChase(match))
+		content.WriteString(m.renderParScore(match))
+		content.WriteString(m.renderProjection(match))
 	}
 
 	return content.String()
 }
 
-// renderTeamScores renders the scores of both teams in a match
-func (m Model) renderTeamScores(scoreDetails models.MatchScoreDetails) string {
+// renderScorecardArea renders the charts, or the scorecard with
+// batting/bowling tabs, shown in the scrollable part of the match view
+func (m Model) renderScorecardArea(match models.MatchInfo) string {
 	var content strings.Builder
 
-	if len(scoreDetails.InningsScoreList) == 0 {
-		return ""
+	// Charts, or the scorecard with batting/bowling tabs
+	if m.chart != chartNone {
+		content.WriteString(m.renderCharts(match))
+	} else if len(match.Scorecard) > 0 && m.currentInnings < len(match.Scorecard) {
+		content.WriteString(m.renderCurrentInningsScorecard(match, m.currentInnings))
 	}
 
-	leftWidth := mainWidth / 2
-	rightWidth := mainWidth / 2
-
-	var leftSide, rightSide strings.Builder
+	return content.String()
+}
 
-	// First innings (left side)
-	if len(scoreDetails.InningsScoreList) > 0 {
-		innings := scoreDetails.InningsScoreList[0]
-		scoreText := m.formatInningsScore(innings)
-		leftSide.WriteString(scoreStyle.Render(scoreText))
+// renderCompleted renders the result of a finished match and, for a live
+// match kept after finishing, when it will leave the list
+func (m Model) renderCompleted(match models.MatchInfo) string {
+	banner := completedStyle.Render("✓ Completed")
+	if result := match.CricbuzzInfo.MatchHeader.Status; result != "" {
+		banner += " " + scoreStyle.Render(result)
+	}
 
-		// Third innings below first innings
-		if len(scoreDetails.InningsScoreList) > 2 {
-			innings3 := scoreDetails.InningsScoreList[2]
-			scoreText3 := m.formatInningsScore(innings3)
-			leftSide.WriteString("\n")
-			leftSide.WriteString(scoreStyle.Render(scoreText3))
+	if m.app != nil {
+		if expires, ok := m.app.ExpiresAt(match); ok {
+			left := max(time.Until(expires), 0)
+			banner += helpStyle.Render(fmt.Sprintf(" • leaves in %s", formatAge(left)))
 		}
 	}
 
-	// Second innings (right side)
-	if len(scoreDetails.InningsScoreList) > 1 {
-		innings := scoreDetails.InningsScoreList[1]
-		scoreText := m.formatInningsScore(innings)
-		rightSide.WriteString(scoreStyle.Render(scoreText))
+	return lipgloss.NewStyle().Width(m.layout.width).Align(lipgloss.Center).Render(banner)
+}
 
-		// Fourth innings below second innings
-		if len(scoreDetails.InningsScoreList) > 3 {
-			innings4 := scoreDetails.InningsScoreList[3]
-			scoreText4 := m.formatInningsScore(innings4)
-			rightSide.WriteString("\n")
-			rightSide.WriteString(scoreStyle.Render(scoreText4))
+// renderTeamScores renders the scores of both teams in a match, each team's
+// innings in its own column in the order they were played
+func (m Model) renderTeamScores(scoreDetails models.MatchScoreDetails) string {
+	innings := scoreDetails.Innings()
+	if len(innings) == 0 {
+		return ""
+	}
+
+	leftWidth := m.layout.width / 2
+	rightWidth := m.layout.width / 2
+
+	// The team batting first goes on the left
+	var leftSide, rightSide []string
+	for _, inn := range innings {
+		scoreText := m.formatInningsScore(inn)
+		if inn.IsFollowOn {
+			scoreText += " f/o"
+		}
+		if inn.BatTeamID == innings[0].BatTeamID {
+			leftSide = append(leftSide, scoreStyle.Render(scoreText))
+		} else {
+			rightSide = append(rightSide, scoreStyle.Render(scoreText))
 		}
 	}
 
@@ -301,41 +884,38 @@ func (m Model) renderTeamScores(scoreDetails models.MatchScoreDetails) string {
 	leftContainer := lipgloss.NewStyle().
 		Width(leftWidth).
 		Align(lipgloss.Left).
-		Render(leftSide.String())
+		Render(strings.Join(leftSide, "\n"))
 
 	rightContainer := lipgloss.NewStyle().
 		Width(rightWidth).
 		Align(lipgloss.Right).
-		Render(rightSide.String())
+		Render(strings.Join(rightSide, "\n"))
 
 	// Join horizontally
 	teamScoresRow := lipgloss.JoinHorizontal(lipgloss.Top, leftContainer, rightContainer)
 
 	// Center the row
-	centeredRow := lipgloss.NewStyle().
-		Width(mainWidth).
+	return lipgloss.NewStyle().
+		Width(m.layout.width).
 		Align(lipgloss.Center).
 		Render(teamScoresRow)
-
-	content.WriteString(centeredRow)
-	return content.String()
 }
 
 // formatInningsScore formats the innings score for display
 func (m Model) formatInningsScore(innings models.InningsScore) string {
 	if innings.IsDeclared {
-		return fmt.Sprintf("%s %d/%d D (%.1f)",
+		return fmt.Sprintf("%s %d/%d D (%s)",
 			innings.BatTeamName,
 			innings.Score,
 			innings.Wickets,
 			innings.Overs)
 	} else if innings.Wickets == 10 {
-		return fmt.Sprintf("%s %d (%.1f)",
+		return fmt.Sprintf("%s %d (%s)",
 			innings.BatTeamName,
 			innings.Score,
 			innings.Overs)
 	} else {
-		return fmt.Sprintf("%s %d/%d (%.1f)",
+		return fmt.Sprintf("%s %d/%d (%s)",
 			innings.BatTeamName,
 			innings.Score,
 			innings.Wickets,
@@ -344,7 +924,7 @@ func (m Model) formatInningsScore(innings models.InningsScore) string {
 }
 
 // renderCurrentInnings renders the current innings information including batsmen and bowler details
-func (m Model) renderCurrentInnings(miniscore models.CricbuzzMiniscore) string {
+func (m Model) renderCurrentInnings(miniscore models.CricbuzzMiniscore, control stats.Control) string {
 	var content strings.Builder
 
 	// Show live if there is no status and the match is in progress
@@ -355,7 +935,7 @@ func (m Model) renderCurrentInnings(miniscore models.CricbuzzMiniscore) string {
 
 	// Match status
 	if miniscore.Status != "" {
-		statusStyled := statusStyle.Width(mainWidth).Align(lipgloss.Center)
+		statusStyled := statusStyle.Width(m.layout.width).Align(lipgloss.Center)
 		content.WriteString(statusStyled.Render(miniscore.Status))
 		content.WriteString("\n\n\n")
 	}
@@ -381,15 +961,15 @@ func (m Model) renderCurrentInnings(miniscore models.CricbuzzMiniscore) string {
 	rightSide.WriteString(scoreStyle.Render(bowlerName))
 	rightSide.WriteString("\n")
 
-	bowlerFigures := fmt.Sprintf("%d-%d (%.1f)",
+	bowlerFigures := fmt.Sprintf("%d-%d (%s)",
 		miniscore.BowlerStriker.BowlWkts,
 		miniscore.BowlerStriker.BowlRuns,
 		miniscore.BowlerStriker.BowlOvs)
 	rightSide.WriteString(scoreStyle.Render(bowlerFigures))
 
 	// Layout current batsmen and bowler
-	leftWidth := mainWidth * 2 / 3
-	rightWidth := mainWidth / 3
+	leftWidth := m.layout.width * 2 / 3
+	rightWidth := m.layout.width / 3
 
 	leftContainer := lipgloss.NewStyle().
 		Width(leftWidth).
@@ -405,71 +985,171 @@ func (m Model) renderCurrentInnings(miniscore models.CricbuzzMiniscore) string {
 	currentInningsRow := lipgloss.JoinHorizontal(lipgloss.Top, leftContainer, rightContainer)
 	content.WriteString(currentInningsRow)
 
+	// Minutes and control of the batters in
+	content.WriteString(m.renderBatterControl(miniscore, control))
+
+	// Recent overs, ball by ball
+	if recent := m.renderRecentOvers(miniscore); recent != "" {
+		content.WriteString("\n\n")
+		content.WriteString(recent)
+	}
+
 	return content.String()
 }
 
 // renderCurrentInningsScorecard renders the scorecard for the current innings
-func (m Model) renderCurrentInningsScorecard(innings models.MatchInningsInfo, inningsNumber int) string {
+func (m Model) renderCurrentInningsScorecard(match models.MatchInfo, inningsNumber int) string {
 	var content strings.Builder
 
-	match := m.app.Matches[m.selectedMatch]
+	innings := match.Scorecard[inningsNumber]
 
 	// Display innings indicator based on match format
-	inningsIndicator := m.renderInningsIndicator(inningsNumber, len(match.Scorecard))
-	scorecardTabs := m.renderScorecardTabs()
+	scorecardTabs := ""
+	if !m.layout.wide {
+		scorecardTabs = m.renderScorecardTabs()
+	}
+	indicatorWidth := m.layout.scorecardWidth - lipgloss.Width(scorecardTabs)
+	inningsIndicator := m.renderInningsIndicator(match, inningsNumber, len(match.Scorecard), indicatorWidth)
 
 	headerRow := lipgloss.JoinHorizontal(
 		lipgloss.Top,
-		lipgloss.NewStyle().Width(30).Align(lipgloss.Left).Render(inningsIndicator),
-		lipgloss.NewStyle().Width(mainWidth-30).Align(lipgloss.Right).Render(scorecardTabs),
+		lipgloss.NewStyle().Width(indicatorWidth).Align(lipgloss.Left).Render(inningsIndicator),
+		lipgloss.NewStyle().Width(m.layout.scorecardWidth-indicatorWidth).Align(lipgloss.Right).Render(scorecardTabs),
 	)
 
 	content.WriteString(fmt.Sprintf("\n%s\n", headerRow))
 
-	// Display the Batting or Bowling card based on the toggle
-	if m.showBowling {
-		if len(innings.BowlerDetails) > 0 {
-			content.WriteString(m.renderBowlingCard(innings.BowlerDetails))
-		} else {
-			content.WriteString(statusStyle.Render("No bowling data available for this innings"))
-		}
-	} else {
-		if len(innings.BatsmanDetails) > 0 {
-			content.WriteString(m.renderBattingCard(innings.BatsmanDetails))
-		} else {
-			content.WriteString(statusStyle.Render("No batting data available for this innings"))
-		}
+	// Batting and bowling side by side on wide terminals, otherwise the one
+	// picked with the toggle
+	control := m.controls[match.CricbuzzMatchID]
+	inningsID := scorecardInningsID(match, inningsNumber)
+	switch {
+	case m.layout.wide:
+		batting := lipgloss.NewStyle().Width(m.layout.cardWidth + cardGap).
+			Render(m.renderBattingSection(innings, control, inningsID))
+		content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, batting, m.renderBowlingSection(innings, control, inningsID)))
+	case m.showBowling:
+		content.WriteString(m.renderBowlingSection(innings, control, inningsID))
+	default:
+		content.WriteString(m.renderBattingSection(innings, control, inningsID))
 	}
+	content.WriteString(m.renderWarnings(innings.Warnings))
 	content.WriteString("\n")
 
 	return content.String()
 }
 
-func (m Model) renderInningsIndicator(currentInnings, totalInnings int) string {
-	// Create the tabs
-	var inningsTabs []string
-	for i := 0; i < totalInnings; i++ {
-		tabLabel := ""
-		switch i {
-		case 0:
-			tabLabel = fmt.Sprintf("%dst", i+1)
-		case 1:
-			tabLabel = fmt.Sprintf("%dnd", i+1)
-		case 2:
-			tabLabel = fmt.Sprintf("%drd", i+1)
-		default:
-			tabLabel = fmt.Sprintf("%dth", i+1)
-		}
+// renderBattingSection renders the batting card of an innings with its
+// extras and total
+func (m Model) renderBattingSection(innings models.MatchInningsInfo, control stats.Control, inningsID uint32) string {
+	if len(innings.BatsmanDetails) == 0 {
+		return statusStyle.Render("No batting data available for this innings")
+	}
+	return m.renderBattingCard(innings.BatsmanDetails, control, inningsID, m.layout.cardWidth) +
+		m.renderInningsTotals(innings)
+}
 
-		if i == currentInnings {
-			inningsTabs = append(inningsTabs, activeTabStyle.Render(tabLabel))
-		} else {
-			inningsTabs = append(inningsTabs, tabStyle.Render(tabLabel))
+// renderBowlingSection renders the bowling card of an innings
+func (m Model) renderBowlingSection(innings models.MatchInningsInfo, control stats.Control, inningsID uint32) string {
+	if len(innings.BowlerDetails) == 0 {
+		return statusStyle.Render("No bowling data available for this innings")
+	}
+	return m.renderBowlingCard(innings.BowlerDetails, control, inningsID, m.layout.cardWidth)
+}
+
+// scorecardInningsID returns the Cricbuzz innings ID of a scorecard innings
+func scorecardInningsID(match models.MatchInfo, inningsNumber int) uint32 {
+	innings := match.CricbuzzInfo.Miniscore.MatchScoreDetails.Innings()
+	if inningsNumber < len(innings) {
+		return innings[inningsNumber].InningsID
+	}
+	return uint32(inningsNumber + 1)
+}
+
+// renderInningsTotals renders the extras and total rows below the batting card
+func (m Model) renderInningsTotals(innings models.MatchInningsInfo) string {
+	if innings.Extras == "" && innings.Total == "" {
+		return ""
+	}
+
+	rowFormat := fmt.Sprintf("%%-%ds %%s", 8)
+	var content strings.Builder
+	content.WriteString("\n")
+	if innings.Extras != "" {
+		content.WriteString(rowStyle.Render(truncateString(fmt.Sprintf(rowFormat, "Extras", innings.Extras), m.layout.cardWidth-2)))
+		content.WriteString("\n")
+	}
+	if innings.Total != "" {
+		content.WriteString(tableHeaderStyle.Render(truncateString(fmt.Sprintf(rowFormat, "Total", innings.Total), m.layout.cardWidth-2)))
+		content.WriteString("\n")
+	}
+	return content.String()
+}
+
+// renderWarnings renders a single line about scorecard figures that do not add up
+func (m Model) renderWarnings(warnings []string) string {
+	if len(warnings) == 0 {
+		return ""
+	}
+
+	line := "⚠ " + warnings[0]
+	if len(warnings) > 1 {
+		line += fmt.Sprintf(" (+%d more)", len(warnings)-1)
+	}
+	return "\n" + helpStyle.Render(truncateString(line, m.layout.scorecardWidth)) + "\n"
+}
+
+// renderInningsIndicator renders the innings tabs, named after the side
+// batting, e.g. "IND 1". Tabs that do not fit the width fall back to the
+// innings positions.
+func (m Model) renderInningsIndicator(match models.MatchInfo, currentInnings, totalInnings, width int) string {
+	positions := make([]string, totalInnings)
+	numbers := make([]string, totalInnings)
+	for i := range totalInnings {
+		positions[i] = stats.Ordinal(i + 1)
+		numbers[i] = fmt.Sprint(i + 1)
+	}
+
+	var tabs string
+	for _, labels := range [][]string{inningsLabels(match, totalInnings), positions, numbers} {
+		var inningsTabs []string
+		for i, label := range labels {
+			style := tabStyle
+			if i == currentInnings {
+				style = activeTabStyle
+			}
+			inningsTabs = append(inningsTabs, zone{zoneInningsTab, i}.mark(style.Render(label)))
+		}
+		tabs = lipgloss.JoinHorizontal(lipgloss.Center, inningsTabs...)
+		if lipgloss.Width(tabs) <= width {
+			break
 		}
 	}
+	return tabs
+}
 
-	// Join tabs together
-	return lipgloss.JoinHorizontal(lipgloss.Center, inningsTabs...)
+// inningsLabels names the innings of a match after the side batting. Sides
+// that bat more than once, as in multi-day matches, get the number of their
+// innings too, e.g. "IND 2".
+func inningsLabels(match models.MatchInfo, totalInnings int) []string {
+	innings := match.CricbuzzInfo.Miniscore.MatchScoreDetails.Innings()
+	numbered := !stats.IsLimitedOvers(match.CricbuzzInfo.MatchHeader.MatchFormat)
+
+	labels := make([]string, totalInnings)
+	batted := map[uint32]int{}
+	for i := range labels {
+		if i >= len(innings) || innings[i].BatTeamName == "" {
+			labels[i] = stats.Ordinal(i + 1)
+			continue
+		}
+		inn := innings[i]
+		batted[inn.BatTeamID]++
+		labels[i] = inn.BatTeamName
+		if numbered || batted[inn.BatTeamID] > 1 {
+			labels[i] += fmt.Sprintf(" %d", batted[inn.BatTeamID])
+		}
+	}
+	return labels
 }
 
 // renderScorecardTabs renders the tabs for switching between batting and bowling scorecards
@@ -483,39 +1163,56 @@ func (m Model) renderScorecardTabs() string {
 		battingTab = activeTabStyle.Render("Bat")
 		bowlingTab = tabStyle.Render("Bowl")
 	}
+	battingTab = zone{zoneScorecardTab, 0}.mark(battingTab)
+	bowlingTab = zone{zoneScorecardTab, 1}.mark(bowlingTab)
 
 	tabs := lipgloss.JoinHorizontal(lipgloss.Center, battingTab, bowlingTab)
 	return lipgloss.NewStyle().MarginBottom(1).Render(tabs)
 }
 
-// renderBattingCard renders the batting scoreboard for the current innings
-func (m Model) renderBattingCard(batsmen []models.BatsmanInfo) string {
+// renderBattingCard renders the batting scoreboard for the current innings,
+// with the control stats of each batter under their figures. Narrow cards
+// leave out the 4s, 6s and S/R columns.
+func (m Model) renderBattingCard(batsmen []models.BatsmanInfo, control stats.Control, inningsID uint32, width int) string {
 	if len(batsmen) == 0 {
 		return ""
 	}
 
 	var content strings.Builder
 
-	otherColumnsWidth := 24 // 5 + 5 + 3 + 3 + 8 for R, B, 4s, 6s, S/R
-	nameWidth := mainWidth - otherColumnsWidth - 7
-
 	// Dynamic header formatting
-	headerFormat := fmt.Sprintf("%%-%ds %%5s %%4s %%4s %%3s %%8s", nameWidth)
-	headerRow := fmt.Sprintf(headerFormat, "Batsman", "R", "B", "4s", "6s", "S/R")
+	otherColumnsWidth := 24 // 5 + 5 + 3 + 3 + 8 for R, B, 4s, 6s, S/R
+	columnsFormat := "%%-%ds %%5s %%4s %%4s %%3s %%8s"
+	if narrow(width) {
+		otherColumnsWidth = 10 // 5 + 5 for R, B
+		columnsFormat = "%%-%ds %%5s %%4s"
+	}
+	nameWidth := width - otherColumnsWidth - 7
+	rowFormat := fmt.Sprintf(columnsFormat, nameWidth)
+	columns := func(name string, runs, balls, fours, sixes, strikeRate string) string {
+		if narrow(width) {
+			return fmt.Sprintf(rowFormat, name, runs, balls)
+		}
+		return fmt.Sprintf(rowFormat, name, runs, balls, fours, sixes, strikeRate)
+	}
 
+	headerRow := columns("Batsman", "R", "B", "4s", "6s", "S/R")
 	content.WriteString(tableHeaderStyle.Render(headerRow))
 	content.WriteString("\n")
 
 	// Separator line
-	separator := strings.Repeat("─", mainWidth)
+	separator := strings.Repeat("─", width)
 	content.WriteString(helpStyle.Render(separator))
 	content.WriteString("\n")
 
-	// Data rows with dynamic formatting
-	rowFormat := fmt.Sprintf("%%-%ds %%5s %%4s %%4s %%3s %%8s", nameWidth)
+	// Data rows, the one under the mouse highlighted
+	for i, bat := range batsmen {
+		row := zone{zoneBattingRow, i}
+		style := rowStyle
+		if m.hovered == row {
+			style = hoverRowStyle
+		}
 
-	// Data rows
-	for _, bat := range batsmen {
 		// Check if player is out
 		isOut := bat.Status != "" &&
 			bat.Status != "not out" &&
@@ -523,7 +1220,7 @@ func (m Model) renderBattingCard(batsmen []models.BatsmanInfo) string {
 			!strings.Contains(strings.ToLower(bat.Status), "not out")
 
 		// Player stats row
-		nameRow := fmt.Sprintf(rowFormat,
+		nameRow := columns(
 			truncateString(bat.Name, nameWidth),
 			bat.Runs,
 			bat.Balls,
@@ -531,37 +1228,39 @@ func (m Model) renderBattingCard(batsmen []models.BatsmanInfo) string {
 			bat.Sixes,
 			bat.StrikeRate)
 
-		content.WriteString(rowStyle.Render(nameRow))
+		content.WriteString(style.Render(row.mark(nameRow)))
 		content.WriteString("\n")
 
-		// Dismissal info below name
+		// Dismissal info below name, control stats on the right when
+		// there is room for them
+		dismissalRow := "not out"
 		if isOut {
-			dismissalInfo := strings.TrimSpace(bat.Status)
-			dismissalRow := dismissalInfo
-			dismissalStyle := lipgloss.NewStyle().
-				Width(mainWidth).
-				Align(lipgloss.Left).
-				PaddingLeft(1).
-				Foreground(lipgloss.Color("8"))
-			content.WriteString(dismissalStyle.Render(dismissalRow))
-			content.WriteString("\n")
-		} else {
-			dismissalRow := "not out"
-			dismissalStyle := lipgloss.NewStyle().
-				Width(mainWidth).
-				Align(lipgloss.Left).
-				PaddingLeft(1).
-				Foreground(lipgloss.Color("8"))
-			content.WriteString(dismissalStyle.Render(dismissalRow))
-			content.WriteString("\n")
+			dismissalRow = strings.TrimSpace(bat.Status)
+		}
+		controlRow := ""
+		if !narrow(width) {
+			controlRow = formatBatterControl(bat, control, inningsID)
 		}
+		dismissalWidth := max(width-1-lipgloss.Width(controlRow)-1, 10)
+		dismissalRow = fmt.Sprintf("%-*s %s", dismissalWidth, truncateString(dismissalRow, dismissalWidth), controlRow)
+		dismissalStyle := lipgloss.NewStyle().
+			Width(width).
+			Align(lipgloss.Left).
+			PaddingLeft(1).
+			Foreground(lipgloss.Color("8"))
+		if m.hovered == row {
+			dismissalStyle = dismissalStyle.Background(hoverRowStyle.GetBackground())
+		}
+		content.WriteString(dismissalStyle.Render(row.mark(dismissalRow)))
+		content.WriteString("\n")
 	}
 
 	return content.String()
 }
 
-// renderBowlingCard renders the bowling scoreboard for the current innings
-func (m Model) renderBowlingCard(bowlers []models.BowlerInfo) string {
+// renderBowlingCard renders the bowling scoreboard for the current innings.
+// Narrow cards leave out the M and Dot% columns.
+func (m Model) renderBowlingCard(bowlers []models.BowlerInfo, control stats.Control, inningsID uint32, width int) string {
 	if len(bowlers) == 0 {
 		return ""
 	}
@@ -569,46 +1268,113 @@ func (m Model) renderBowlingCard(bowlers []models.BowlerInfo) string {
 	var content strings.Builder
 
 	// Calculate dynamic name column width
-	otherColumnsWidth := 24 // 5 + 4 + 4 + 3 + 8 for O, M, R, W, Econ
-	nameWidth := mainWidth - otherColumnsWidth - 7
-
-	// Dynamic header formatting
-	headerFormat := fmt.Sprintf("%%-%ds %%5s %%4s %%4s %%3s %%8s", nameWidth)
-	headerRow := fmt.Sprintf(headerFormat, "Bowler", "O", "M", "R", "W", "Econ")
+	otherColumnsWidth := 30 // 5 + 4 + 4 + 3 + 8 + 6 for O, M, R, W, Econ, Dot%
+	columnsFormat := "%%-%ds %%5s %%4s %%4s %%3s %%8s %%6s"
+	if narrow(width) {
+		otherColumnsWidth = 20 // 5 + 4 + 3 + 8 for O, R, W, Econ
+		columnsFormat = "%%-%ds %%5s %%4s %%3s %%8s"
+	}
+	nameWidth := width - otherColumnsWidth - 8
+	rowFormat := fmt.Sprintf(columnsFormat, nameWidth)
+	columns := func(name, overs, maidens, runs, wickets, economy, dots string) string {
+		if narrow(width) {
+			return fmt.Sprintf(rowFormat, name, overs, runs, wickets, economy)
+		}
+		return fmt.Sprintf(rowFormat, name, overs, maidens, runs, wickets, economy, dots)
+	}
 
+	headerRow := columns("Bowler", "O", "M", "R", "W", "Econ", "Dot%")
 	content.WriteString(tableHeaderStyle.Render(headerRow))
 	content.WriteString("\n")
 
 	// Separator line
-	separator := strings.Repeat("─", mainWidth)
+	separator := strings.Repeat("─", width)
 	content.WriteString(helpStyle.Render(separator))
 	content.WriteString("\n")
 
-	// Data rows with dynamic formatting
-	rowFormat := fmt.Sprintf("%%-%ds %%5s %%4s %%4s %%3s %%8s", nameWidth)
+	// Data rows, the one under the mouse highlighted
+	for i, bowl := range bowlers {
+		row := zone{zoneBowlingRow, i}
+		style := rowStyle
+		if m.hovered == row {
+			style = hoverRowStyle
+		}
+
+		// Dot balls counted from the commentary, marked when they do not
+		// cover the whole spell
+		dots := "-"
+		if ctl, ok := control.Bowler(inningsID, bowl.Name); ok {
+			dots = fmt.Sprintf("%.0f", ctl.DotPercent())
+			if ctl.Balls < bowl.Stats.Overs.Balls {
+				dots = "~" + dots
+			}
+		}
 
-	// Data rows
-	for _, bowl := range bowlers {
 		// Bowler stats row
-		nameRow := fmt.Sprintf(rowFormat,
+		nameRow := columns(
 			truncateString(bowl.Name, nameWidth),
 			bowl.Overs,
 			bowl.Maidens,
 			bowl.Runs,
 			bowl.Wickets,
-			bowl.Economy)
+			bowl.Economy,
+			dots)
 
-		content.WriteString(rowStyle.Render(nameRow))
+		content.WriteString(style.Render(row.mark(nameRow)))
 		content.WriteString("\n")
 	}
 
 	return content.String()
 }
 
-// truncateString truncates a string to a maximum length and appends "..." if truncated
+// formatBatterControl formats the minutes, dot ball, boundary and scoring
+// shot percentages of a batter, leaving out what is not known
+func formatBatterControl(bat models.BatsmanInfo, control stats.Control, inningsID uint32) string {
+	ctl, ok := control.Batter(inningsID, bat.Name)
+	if !ok || ctl.Balls < bat.Stats.Balls {
+		ctl = stats.BatterControl{Balls: bat.Stats.Balls, Fours: bat.Stats.Fours, Sixes: bat.Stats.Sixes}
+	}
+	if ctl.Balls == 0 {
+		return ""
+	}
+
+	var parts []string
+	if ctl.HasMinutes {
+		parts = append(parts, fmt.Sprintf("%dm", ctl.Minutes))
+	}
+	if ctl.HasDots {
+		parts = append(parts, "dot "+formatDotPercent(ctl, ctl.DotPercent())+"%")
+	}
+	parts = append(parts, fmt.Sprintf("bdry %.0f%%", ctl.BoundaryPercent()))
+	if ctl.HasDots {
+		parts = append(parts, "scr "+formatDotPercent(ctl, ctl.ScoringShotPercent())+"%")
+	}
+	return strings.Join(parts, " · ")
+}
+
+// formatDotPercent formats a percentage worked out from the dot balls of a
+// batter, "-" when they are not known and marked when they were counted from
+// commentary that missed part of the innings
+func formatDotPercent(ctl stats.BatterControl, value float64) string {
+	switch {
+	case !ctl.HasDots:
+		return "-"
+	case ctl.Partial():
+		return fmt.Sprintf("~%.0f", value)
+	default:
+		return fmt.Sprintf("%.0f", value)
+	}
+}
+
+// truncateString truncates a string to a maximum number of characters and
+// appends "..." if truncated, never cutting a character in half
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	if maxLen <= 3 {
+		return string(runes[:max(maxLen, 0)])
+	}
+	return string(runes[:maxLen-3]) + "..."
 }
@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// zoneKind is the kind of part of the view that reacts to the mouse
+type zoneKind int
+
+// Kinds of zones
+const (
+	zoneNone zoneKind = iota
+	zoneMatchTab
+	zoneInningsTab
+	zoneScorecardTab
+	zoneChartTab
+	zoneBattingRow
+	zoneBowlingRow
+)
+
+// zone is a part of the view that reacts to the mouse, like the tab of a
+// match or a row of the batting card
+type zone struct {
+	kind  zoneKind
+	index int
+}
+
+// Zone markers wrap the parts of the view that react to the mouse. They are
+// escape sequences with no width, so they go through lipgloss layout
+// untouched, and are stripped from the final view once found.
+var zoneMarker = regexp.MustCompile(`\x1b\[(\d+);(\d+);([12])z`)
+
+// mark wraps rendered content in the markers of the zone
+func (z zone) mark(content string) string {
+	return fmt.Sprintf("\x1b[%d;%d;1z%s\x1b[%d;%d;2z", z.kind, z.index, content, z.kind, z.index)
+}
+
+// zoneBounds is where a zone ended up on screen, in cells, the bottom right
+// corner included
+type zoneBounds struct {
+	top, left, bottom, right int
+}
+
+// zones keeps where the zones of the last view are on screen. The model
+// holds it by pointer so View can record them.
+type zones struct {
+	bounds map[zone]zoneBounds
+}
+
+// newZones returns an empty zone map
+func newZones() *zones {
+	return &zones{bounds: make(map[zone]zoneBounds)}
+}
+
+// scan records where the zones of a view are and returns the view without
+// the markers. Zones marked several times, like the two lines of a batting
+// card row, cover all of their parts.
+func (z *zones) scan(view string) string {
+	if z == nil {
+		return zoneMarker.ReplaceAllString(view, "")
+	}
+	clear(z.bounds)
+
+	starts := map[zone][2]int{}
+	lines := strings.Split(view, "\n")
+	for row, line := range lines {
+		for _, found := range zoneMarker.FindAllStringSubmatchIndex(line, -1) {
+			kind, _ := strconv.Atoi(line[found[2]:found[3]])
+			index, _ := strconv.Atoi(line[found[4]:found[5]])
+			at := zone{zoneKind(kind), index}
+			col := lipgloss.Width(line[:found[0]])
+
+			if line[found[6]:found[7]] == "1" {
+				starts[at] = [2]int{row, col}
+				continue
+			}
+			start, ok := starts[at]
+			if !ok {
+				continue
+			}
+			delete(starts, at)
+			bounds := zoneBounds{top: start[0], left: start[1], bottom: row, right: col - 1}
+			if old, ok := z.bounds[at]; ok {
+				bounds = zoneBounds{
+					top:    min(old.top, bounds.top),
+					left:   min(old.left, bounds.left),
+					bottom: max(old.bottom, bounds.bottom),
+					right:  max(old.right, bounds.right),
+				}
+			}
+			z.bounds[at] = bounds
+		}
+		lines[row] = zoneMarker.ReplaceAllString(line, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// at returns the zone at a cell of the screen
+func (z *zones) at(x, y int) (zone, bool) {
+	if z == nil {
+		return zone{}, false
+	}
+	for at, b := range z.bounds {
+		if y >= b.top && y <= b.bottom && x >= b.left && x <= b.right {
+			return at, true
+		}
+	}
+	return zone{}, false
+}